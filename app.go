@@ -9,6 +9,7 @@ import (
 	"runtime"
 
 	"github.com/go-rod/rod/lib/launcher"
+	wailsruntime "github.com/wailsapp/wails/v2/pkg/runtime"
 
 	"xtools/internal/adapters/activity"
 	"xtools/internal/adapters/events"
@@ -33,11 +34,13 @@ type App struct {
 	activityLogger *activity.InMemoryLogger
 
 	// Storage
-	configStore      *storage.YAMLConfigStore
-	metricsStore     *storage.SQLiteMetricsStore
-	replyStore       *storage.SQLiteReplyStore
-	polymarketStore  *storage.PolymarketStore
-	excelExporter    *storage.ExcelExporter
+	configStore        *storage.YAMLConfigStore
+	metricsStore       *storage.SQLiteMetricsStore
+	replyStore         *storage.SQLiteReplyStore
+	keywordStatsStore  *storage.SQLiteKeywordStatsStore
+	scheduledPostStore *storage.SQLiteScheduledPostStore
+	polymarketStore    *storage.PolymarketStore
+	excelExporter      *storage.ExcelExporter
 
 	// Services
 	accountSvc      *services.AccountService
@@ -45,6 +48,12 @@ type App struct {
 	replySvc        *services.ReplyService
 	polymarketSvc   *services.PolymarketService
 	notificationSvc *services.NotificationService
+	alertBridgeSvc  *services.AlertBridgeService
+	scheduledSvc    *services.ScheduledPostService
+	profileSvc      *services.ProfileService
+	queueSvc        *services.ApprovalQueueService
+	postingSvc      *services.PostingQueueService
+	replyMetricsSvc *services.ReplyMetricsService
 
 	// Workers
 	workerPool *workers.WorkerPool
@@ -122,6 +131,8 @@ func (a *App) startup(ctx context.Context) {
 	db, err := sql.Open("sqlite3", dbPath+"?_journal_mode=WAL")
 	if err == nil {
 		a.replyStore, _ = storage.NewSQLiteReplyStore(db)
+		a.scheduledPostStore, _ = storage.NewSQLiteScheduledPostStore(db)
+		a.keywordStatsStore, _ = storage.NewSQLiteKeywordStatsStore(db)
 	}
 
 	a.excelExporter, err = storage.NewExcelExporter(exportsDir)
@@ -139,13 +150,25 @@ func (a *App) startup(ctx context.Context) {
 	llmFactory := llm.NewProviderFactory()
 
 	a.accountSvc = services.NewAccountService(a.configStore, clientFactory, a.eventBus)
-	a.searchSvc = services.NewSearchService(a.accountSvc, a.metricsStore, a.excelExporter, a.eventBus)
+	a.searchSvc = services.NewSearchService(a.accountSvc, a.metricsStore, a.keywordStatsStore, a.replyStore, a.excelExporter, a.eventBus)
 	a.replySvc = services.NewReplyService(a.accountSvc, a.searchSvc, llmFactory, a.replyStore, a.metricsStore, a.eventBus, a.activityLogger)
 	a.polymarketSvc = services.NewPolymarketService(a.polymarketStore, a.eventBus, dbPath)
-	a.notificationSvc = services.NewNotificationService(a.polymarketStore, a.eventBus)
+	a.notificationSvc = services.NewNotificationService(a.polymarketStore, a.replyStore, a.metricsStore, a.configStore, a.eventBus)
+	a.alertBridgeSvc = services.NewAlertBridgeService(a.accountSvc, a.replySvc, llmFactory, a.replyStore, a.metricsStore, a.eventBus, a.activityLogger)
+	a.scheduledSvc = services.NewScheduledPostService(a.accountSvc, a.scheduledPostStore, a.eventBus, a.activityLogger)
+	a.profileSvc = services.NewProfileService(a.accountSvc, a.metricsStore)
+	a.queueSvc = services.NewApprovalQueueService(a.replySvc, a.replyStore, a.eventBus, a.activityLogger)
+	a.postingSvc = services.NewPostingQueueService(a.replySvc, a.replyStore)
+	a.replyMetricsSvc = services.NewReplyMetricsService(a.accountSvc, a.replyStore, a.metricsStore)
 
 	// Start notification service to listen for events
 	a.notificationSvc.Start()
+	a.alertBridgeSvc.Start()
+	a.scheduledSvc.Start()
+	a.profileSvc.Start()
+	a.queueSvc.Start()
+	a.postingSvc.Start()
+	a.replyMetricsSvc.Start()
 
 	// Initialize worker pool
 	a.workerPool = workers.NewWorkerPool(a.searchSvc, a.replySvc, a.configStore, a.eventBus, a.activityLogger)
@@ -157,6 +180,9 @@ func (a *App) startup(ctx context.Context) {
 		a.replySvc,
 		a.polymarketSvc,
 		a.notificationSvc,
+		a.scheduledSvc,
+		a.queueSvc,
+		a.replyMetricsSvc,
 		a.workerPool,
 		a.configStore,
 		a.metricsStore,
@@ -199,6 +225,24 @@ func (a *App) shutdown(ctx context.Context) {
 	if a.notificationSvc != nil {
 		a.notificationSvc.Stop()
 	}
+	if a.alertBridgeSvc != nil {
+		a.alertBridgeSvc.Stop()
+	}
+	if a.scheduledSvc != nil {
+		a.scheduledSvc.Stop()
+	}
+	if a.profileSvc != nil {
+		a.profileSvc.Stop()
+	}
+	if a.queueSvc != nil {
+		a.queueSvc.Stop()
+	}
+	if a.postingSvc != nil {
+		a.postingSvc.Stop()
+	}
+	if a.replyMetricsSvc != nil {
+		a.replyMetricsSvc.Stop()
+	}
 }
 
 // === Exposed Methods (Wails Bindings) ===
@@ -268,6 +312,26 @@ func (a *App) SearchTweets(accountID string) ([]domain.Tweet, error) {
 	return a.handlers.SearchTweets(accountID)
 }
 
+// ImportUsernameList opens a file picker and returns the usernames in the chosen
+// text or CSV file (nil if cancelled)
+func (a *App) ImportUsernameList() ([]string, error) {
+	path, err := wailsruntime.OpenFileDialog(a.ctx, wailsruntime.OpenDialogOptions{
+		Title: "Import Usernames",
+		Filters: []wailsruntime.FileFilter{
+			{DisplayName: "Text and CSV files", Pattern: "*.txt;*.csv"},
+		},
+	})
+	if err != nil || path == "" {
+		return nil, err
+	}
+	return a.handlers.ImportUsernameList(path)
+}
+
+// PreviewSearchQuery validates an account's search settings and returns the query they produce
+func (a *App) PreviewSearchQuery(cfg domain.AccountConfig) (string, error) {
+	return a.handlers.PreviewSearchQuery(cfg)
+}
+
 // ManualSearch performs a custom search
 func (a *App) ManualSearch(accountID, query string, maxResults int) ([]domain.Tweet, error) {
 	return a.handlers.ManualSearch(accountID, query, maxResults)
@@ -288,16 +352,61 @@ func (a *App) ApproveReply(replyID string) error {
 	return a.handlers.ApproveReply(replyID)
 }
 
+// PostReplyNow posts a scheduled reply on the next posting queue check
+func (a *App) PostReplyNow(replyID string) error {
+	return a.handlers.PostReplyNow(replyID)
+}
+
+// DeleteReply deletes a posted reply from X
+func (a *App) DeleteReply(replyID string) error {
+	return a.handlers.DeleteReply(replyID)
+}
+
 // RejectReply rejects a reply
 func (a *App) RejectReply(replyID string) error {
 	return a.handlers.RejectReply(replyID)
 }
 
+// GetAllPendingReplies returns the approval queue across accounts
+func (a *App) GetAllPendingReplies(filter domain.PendingFilter) ([]domain.ApprovalQueueItem, error) {
+	return a.handlers.GetAllPendingReplies(filter)
+}
+
+// ApproveReplies approves and posts several pending replies
+func (a *App) ApproveReplies(replyIDs []string) domain.BulkResult {
+	return a.handlers.ApproveReplies(replyIDs)
+}
+
+// RejectReplies rejects several pending replies
+func (a *App) RejectReplies(replyIDs []string) domain.BulkResult {
+	return a.handlers.RejectReplies(replyIDs)
+}
+
+// SelectReplyCandidate picks one of a pending reply's candidates
+func (a *App) SelectReplyCandidate(replyID string, index int) error {
+	return a.handlers.SelectReplyCandidate(replyID, index)
+}
+
 // EditReply edits a pending reply
 func (a *App) EditReply(replyID, newText string) error {
 	return a.handlers.EditReply(replyID, newText)
 }
 
+// SetReplyMedia replaces a pending reply's attachments
+func (a *App) SetReplyMedia(replyID string, media []domain.MediaAttachment) error {
+	return a.handlers.SetReplyMedia(replyID, media)
+}
+
+// SelectMediaFiles opens a file picker for images and GIFs
+func (a *App) SelectMediaFiles() ([]string, error) {
+	return wailsruntime.OpenMultipleFilesDialog(a.ctx, wailsruntime.OpenDialogOptions{
+		Title: "Attach Media",
+		Filters: []wailsruntime.FileFilter{
+			{DisplayName: "Images and GIFs", Pattern: "*.jpg;*.jpeg;*.png;*.webp;*.gif"},
+		},
+	})
+}
+
 // GetReplyHistory returns reply history
 func (a *App) GetReplyHistory(accountID string, limit int) ([]domain.Reply, error) {
 	return a.handlers.GetReplyHistory(accountID, limit)
@@ -308,6 +417,36 @@ func (a *App) GenerateReply(accountID string, tweet domain.Tweet) (*domain.Reply
 	return a.handlers.GenerateReply(accountID, tweet)
 }
 
+// SchedulePost queues a tweet, quote tweet or thread
+func (a *App) SchedulePost(post domain.ScheduledPost) (*domain.ScheduledPost, error) {
+	return a.handlers.SchedulePost(post)
+}
+
+// UpdateScheduledPost edits a scheduled post
+func (a *App) UpdateScheduledPost(post domain.ScheduledPost) error {
+	return a.handlers.UpdateScheduledPost(post)
+}
+
+// CancelScheduledPost cancels a scheduled post
+func (a *App) CancelScheduledPost(postID string) error {
+	return a.handlers.CancelScheduledPost(postID)
+}
+
+// DeleteScheduledPost deletes a scheduled post
+func (a *App) DeleteScheduledPost(postID string) error {
+	return a.handlers.DeleteScheduledPost(postID)
+}
+
+// PublishScheduledPost publishes a scheduled post now
+func (a *App) PublishScheduledPost(postID string) error {
+	return a.handlers.PublishScheduledPost(postID)
+}
+
+// GetScheduledPosts returns scheduled posts
+func (a *App) GetScheduledPosts(accountID string, limit int) ([]domain.ScheduledPost, error) {
+	return a.handlers.GetScheduledPosts(accountID, limit)
+}
+
 // GetProfileHistory returns profile metrics
 func (a *App) GetProfileHistory(accountID string, days int) ([]domain.ProfileSnapshot, error) {
 	return a.handlers.GetProfileHistory(accountID, days)
@@ -318,11 +457,21 @@ func (a *App) GetReplyPerformance(accountID string, days int) (*domain.ReplyPerf
 	return a.handlers.GetReplyPerformance(accountID, days)
 }
 
+// GetExperimentResults compares engagement across an account's experiment variants
+func (a *App) GetExperimentResults(accountID string) (*domain.ExperimentResults, error) {
+	return a.handlers.GetExperimentResults(accountID)
+}
+
 // GetDailyStats returns daily statistics
 func (a *App) GetDailyStats(accountID string, days int) ([]domain.DailyStats, error) {
 	return a.handlers.GetDailyStats(accountID, days)
 }
 
+// GetKeywordStats returns per-keyword search and reply performance
+func (a *App) GetKeywordStats(accountID string, days int) ([]domain.KeywordStats, error) {
+	return a.handlers.GetKeywordStats(accountID, days)
+}
+
 // ExportTweets exports tweets to Excel
 func (a *App) ExportTweets(accountID, path string) error {
 	return a.handlers.ExportTweets(accountID, path)
@@ -457,6 +606,21 @@ func (a *App) SendTestNotification() error {
 	return a.handlers.SendTestNotification()
 }
 
+// SendNotificationDigest emails the daily digest immediately
+func (a *App) SendNotificationDigest() error {
+	return a.handlers.SendNotificationDigest()
+}
+
+// PreviewNotificationTemplate renders a notification template against sample data
+func (a *App) PreviewNotificationTemplate(tmpl domain.NotificationTemplate) (string, error) {
+	return a.handlers.PreviewNotificationTemplate(tmpl)
+}
+
+// GetDefaultNotificationTemplates returns the built-in notification templates
+func (a *App) GetDefaultNotificationTemplates() []domain.NotificationTemplate {
+	return a.handlers.GetDefaultNotificationTemplates()
+}
+
 // GetBrowserPath returns the detected browser path for cookie extraction
 func (a *App) GetBrowserPath() string {
 	path, found := launcher.LookPath()
@@ -4,13 +4,17 @@ import Dashboard from './pages/Dashboard';
 import Accounts from './pages/Accounts';
 import AccountDetail from './pages/AccountDetail';
 import Search from './pages/Search';
+import Approvals from './pages/Approvals';
 import Metrics from './pages/Metrics';
 import Settings from './pages/Settings';
 import PolymarketWatcher from './pages/PolymarketWatcher';
 import PolymarketWallets from './pages/PolymarketWallets';
 import { Toaster } from './components/ui/sonner';
+import { useDesktopNotifications } from './hooks/use-desktop-notifications';
 
 function App() {
+    useDesktopNotifications();
+
     return (
         <BrowserRouter>
             <Routes>
@@ -19,6 +23,7 @@ function App() {
                     <Route path="accounts" element={<Accounts />} />
                     <Route path="accounts/:accountId" element={<AccountDetail />} />
                     <Route path="search" element={<Search />} />
+                    <Route path="approvals" element={<Approvals />} />
                     <Route path="metrics" element={<Metrics />} />
                     <Route path="polymarket" element={<PolymarketWatcher />} />
                     <Route path="polymarket/wallets" element={<PolymarketWallets />} />
@@ -1,7 +1,7 @@
 import { useState, useEffect } from 'react';
-import { Save, Plus, Trash2, Key, Loader2, ChevronLeft, ChevronRight } from 'lucide-react';
-import { AccountConfig } from '../types';
-import { ExtractCookies } from '../../wailsjs/go/main/App';
+import { Save, Plus, Trash2, Key, Loader2, ChevronLeft, ChevronRight, Upload } from 'lucide-react';
+import { AccountConfig, ExperimentVariant, KeywordGroup, SearchFilters } from '../types';
+import { ExtractCookies, ImportUsernameList, PreviewSearchQuery } from '../../wailsjs/go/main/App';
 import { Button } from './ui/button';
 import {
     Dialog,
@@ -52,6 +52,8 @@ export default function AccountEditor({ account, isCreating, onSave, onClose, sh
     const [isExtracting, setIsExtracting] = useState(false);
     const [currentStep, setCurrentStep] = useState(1);
     const [completedSteps, setCompletedSteps] = useState<Set<number>>(new Set());
+    const [queryPreview, setQueryPreview] = useState('');
+    const [queryError, setQueryError] = useState('');
 
     useEffect(() => {
         if (account) {
@@ -62,6 +64,22 @@ export default function AccountEditor({ account, isCreating, onSave, onClose, sh
         }
     }, [account, isCreating]);
 
+    // Render and validate the search query as the account's client would send it
+    useEffect(() => {
+        if (!formData.searchConfig) return;
+        const timer = setTimeout(async () => {
+            try {
+                const query = await PreviewSearchQuery(formData as any);
+                setQueryPreview(query);
+                setQueryError('');
+            } catch (err: any) {
+                setQueryPreview('');
+                setQueryError(typeof err === 'string' ? err : (err?.message || 'Invalid search query'));
+            }
+        }, 300);
+        return () => clearTimeout(timer);
+    }, [formData.searchConfig, formData.authType]);
+
     if (!account) return null;
 
     const handleChange = (path: string, value: any) => {
@@ -102,6 +120,53 @@ export default function AccountEditor({ account, isCreating, onSave, onClose, sh
         handleChange(path, newArray);
     };
 
+    const addKeywordGroup = () => {
+        const groups = formData.searchConfig?.keywordGroups || [];
+        handleChange('searchConfig.keywordGroups', [...groups, { name: '', keywords: [] }]);
+    };
+
+    const updateKeywordGroup = (index: number, changes: Partial<KeywordGroup>) => {
+        const groups = [...(formData.searchConfig?.keywordGroups || [])];
+        groups[index] = { ...groups[index], ...changes };
+        handleChange('searchConfig.keywordGroups', groups);
+    };
+
+    const addVariant = () => {
+        const variants = formData.experiment?.variants || [];
+        handleChange('experiment.variants', [...variants, { name: '', weight: 1 }]);
+    };
+
+    const updateVariant = (index: number, changes: Partial<ExperimentVariant>) => {
+        const variants = [...(formData.experiment?.variants || [])];
+        variants[index] = { ...variants[index], ...changes };
+        handleChange('experiment.variants', variants);
+    };
+
+    const removeVariant = (index: number) => {
+        const variants = formData.experiment?.variants || [];
+        handleChange('experiment.variants', variants.filter((_, i) => i !== index));
+    };
+
+    const removeKeywordGroup = (index: number) => {
+        const groups = formData.searchConfig?.keywordGroups || [];
+        handleChange('searchConfig.keywordGroups', groups.filter((_, i) => i !== index));
+    };
+
+    const handleImportUsers = async (path: string) => {
+        try {
+            const imported = await ImportUsernameList();
+            if (!imported?.length) return;
+
+            const current: string[] = path.split('.').reduce((obj: any, key) => obj?.[key], formData) || [];
+            const known = new Set(current.map((u) => u.replace(/^@/, '').toLowerCase()));
+            const added = imported.filter((u) => !known.has(u.toLowerCase()));
+            handleChange(path, [...current, ...added]);
+            showToast?.(`Imported ${added.length} username${added.length === 1 ? '' : 's'}`, 'success');
+        } catch (err: any) {
+            showToast?.(typeof err === 'string' ? err : (err?.message || 'Failed to import usernames'), 'error');
+        }
+    };
+
     const handleExtractCookies = async () => {
         setIsExtracting(true);
         showToast?.('Browser opening... Please log in to Twitter', 'info');
@@ -153,6 +218,9 @@ export default function AccountEditor({ account, isCreating, onSave, onClose, sh
                 if (!formData.searchConfig?.keywords?.length) {
                     warnings.push({ message: 'No keywords configured - no tweets will be found', type: 'warning' });
                 }
+                if (queryError) {
+                    warnings.push({ message: queryError, type: 'error' });
+                }
                 break;
             case 4:
                 if (formData.replyConfig?.approvalMode === 'auto') {
@@ -466,6 +534,37 @@ export default function AccountEditor({ account, isCreating, onSave, onClose, sh
             <ArrayField label="Keywords" path="searchConfig.keywords" placeholder="Enter keyword" />
             <ArrayField label="Exclude Keywords" path="searchConfig.excludeKeywords" placeholder="Keyword to exclude" />
             <ArrayField label="Blocklist (usernames)" path="searchConfig.blocklist" placeholder="Username to block" />
+            <ArrayField label="Allowlist (always reply, handled first)" path="searchConfig.allowlist" placeholder="Username to prioritize" />
+
+            <div className="grid grid-cols-1 sm:grid-cols-2 gap-4">
+                <div className="space-y-2">
+                    <Label>Min Author Score (0-100)</Label>
+                    <Input
+                        type="number"
+                        min={0}
+                        max={100}
+                        value={formData.searchConfig?.minAuthorScore || 0}
+                        onChange={(e) => handleChange('searchConfig.minAuthorScore', parseInt(e.target.value) || 0)}
+                    />
+                    <p className="text-xs text-muted-foreground">
+                        Scores reach, account age, verification, follower ratio, bot signals and past replies. 0 disables; unknown profiles score 50.
+                    </p>
+                </div>
+                <div className="space-y-2">
+                    <Label>Import Usernames</Label>
+                    <div className="flex gap-2">
+                        <Button type="button" variant="outline" size="sm" onClick={() => handleImportUsers('searchConfig.blocklist')}>
+                            <Upload size={14} /> Blocklist
+                        </Button>
+                        <Button type="button" variant="outline" size="sm" onClick={() => handleImportUsers('searchConfig.allowlist')}>
+                            <Upload size={14} /> Allowlist
+                        </Button>
+                    </div>
+                    <p className="text-xs text-muted-foreground">
+                        Text file with one username per line, or CSV with usernames in the first column
+                    </p>
+                </div>
+            </div>
 
             <div className="grid grid-cols-2 sm:grid-cols-4 gap-4">
                 <div className="space-y-2">
@@ -502,7 +601,7 @@ export default function AccountEditor({ account, isCreating, onSave, onClose, sh
                 </div>
             </div>
 
-            <div className="grid grid-cols-2 gap-4">
+            <div className="grid grid-cols-3 gap-4">
                 <div className="space-y-2">
                     <Label>Min Retweets</Label>
                     <Input
@@ -511,6 +610,19 @@ export default function AccountEditor({ account, isCreating, onSave, onClose, sh
                         onChange={(e) => handleChange('searchConfig.minRetweets', parseInt(e.target.value))}
                     />
                 </div>
+                <div className="space-y-2">
+                    <Label>Max Pages per Run</Label>
+                    <Input
+                        type="number"
+                        min={1}
+                        max={10}
+                        value={formData.searchConfig?.maxPages || 1}
+                        onChange={(e) => handleChange('searchConfig.maxPages', parseInt(e.target.value))}
+                    />
+                    <p className="text-xs text-muted-foreground">
+                        Only tweets newer than the last run are fetched (API accounts page through results)
+                    </p>
+                </div>
                 <div className="flex items-center gap-3 p-4 bg-secondary/50 rounded-lg border border-border">
                     <Checkbox
                         checked={formData.searchConfig?.englishOnly || false}
@@ -519,6 +631,197 @@ export default function AccountEditor({ account, isCreating, onSave, onClose, sh
                     <Label className="cursor-pointer">English Only</Label>
                 </div>
             </div>
+
+            <div className="space-y-4 p-4 rounded-lg border border-border">
+                <div>
+                    <Label>Advanced Query</Label>
+                    <p className="text-xs text-muted-foreground">
+                        Added to every search. Keywords above match as "any of these words".
+                    </p>
+                </div>
+                <div className="grid grid-cols-1 sm:grid-cols-2 gap-4">
+                    <ArrayField label="All of these words" path="searchConfig.query.allWords" placeholder="Word" />
+                    <ArrayField label="Exact phrases" path="searchConfig.query.exactPhrases" placeholder="Phrase" />
+                    <ArrayField label="None of these words" path="searchConfig.query.noneWords" placeholder="Word" />
+                    <ArrayField label="Hashtags" path="searchConfig.query.hashtags" placeholder="#hashtag" />
+                    <ArrayField label="From accounts" path="searchConfig.query.fromUsers" placeholder="@username" />
+                    <ArrayField label="Replying to accounts" path="searchConfig.query.toUsers" placeholder="@username" />
+                    <ArrayField label="Mentioning accounts" path="searchConfig.query.mentions" placeholder="@username" />
+                </div>
+                <div className="grid grid-cols-2 sm:grid-cols-4 gap-4">
+                    <div className="space-y-2">
+                        <Label>Language</Label>
+                        <Input
+                            value={formData.searchConfig?.query?.lang || ''}
+                            onChange={(e) => handleChange('searchConfig.query.lang', e.target.value.trim())}
+                            placeholder="e.g. en"
+                        />
+                    </div>
+                    <div className="space-y-2">
+                        <Label>Since</Label>
+                        <Input
+                            type="date"
+                            value={formData.searchConfig?.query?.since || ''}
+                            onChange={(e) => handleChange('searchConfig.query.since', e.target.value)}
+                        />
+                    </div>
+                    <div className="space-y-2">
+                        <Label>Until</Label>
+                        <Input
+                            type="date"
+                            value={formData.searchConfig?.query?.until || ''}
+                            onChange={(e) => handleChange('searchConfig.query.until', e.target.value)}
+                        />
+                    </div>
+                    <div className="space-y-2">
+                        <Label>Min Views</Label>
+                        <Input
+                            type="number"
+                            min={0}
+                            value={formData.searchConfig?.query?.minViews || 0}
+                            onChange={(e) => handleChange('searchConfig.query.minViews', parseInt(e.target.value) || 0)}
+                        />
+                    </div>
+                </div>
+                <div className="flex flex-wrap gap-6">
+                    {([
+                        ['hasMedia', 'Has media'],
+                        ['hasLinks', 'Has links'],
+                        ['verifiedOnly', 'Verified accounts only'],
+                    ] as const).map(([key, label]) => (
+                        <div key={key} className="flex items-center gap-2">
+                            <Checkbox
+                                checked={formData.searchConfig?.query?.[key] || false}
+                                onCheckedChange={(checked) => handleChange(`searchConfig.query.${key}`, checked)}
+                            />
+                            <Label className="cursor-pointer">{label}</Label>
+                        </div>
+                    ))}
+                </div>
+                <div className="space-y-1">
+                    <Label>Query Preview</Label>
+                    {queryError ? (
+                        <p className="text-sm text-destructive">{queryError}</p>
+                    ) : (
+                        <code className="block text-xs p-2 rounded bg-secondary/50 break-all">
+                            {queryPreview || 'Add keywords or query terms to search'}
+                        </code>
+                    )}
+                </div>
+            </div>
+
+            <div className="space-y-3">
+                <div className="flex items-center gap-3 p-4 bg-secondary/50 rounded-lg border border-border">
+                    <Checkbox
+                        checked={formData.searchConfig?.perKeyword || false}
+                        onCheckedChange={(checked) => handleChange('searchConfig.perKeyword', checked)}
+                    />
+                    <div>
+                        <Label className="cursor-pointer">Run each keyword as its own query</Label>
+                        <p className="text-xs text-muted-foreground">
+                            Keywords not in a group below are searched one by one with the filters above
+                        </p>
+                    </div>
+                </div>
+
+                {formData.searchConfig?.perKeyword && (
+                    <div className="space-y-3">
+                        <div className="flex items-center justify-between">
+                            <Label>Keyword Groups</Label>
+                            <button
+                                type="button"
+                                onClick={addKeywordGroup}
+                                className="flex items-center gap-1 text-xs text-primary hover:text-primary/80 transition-colors"
+                            >
+                                <Plus size={14} /> Add Group
+                            </button>
+                        </div>
+                        {(formData.searchConfig?.keywordGroups || []).map((group, index) => (
+                            <div key={index} className="space-y-3 p-4 rounded-lg border border-border">
+                                <div className="grid grid-cols-1 sm:grid-cols-3 gap-3">
+                                    <div className="space-y-2">
+                                        <Label>Name</Label>
+                                        <Input
+                                            value={group.name || ''}
+                                            onChange={(e) => updateKeywordGroup(index, { name: e.target.value })}
+                                            placeholder="e.g. Crypto"
+                                        />
+                                    </div>
+                                    <div className="space-y-2 sm:col-span-2">
+                                        <Label>Keywords (comma-separated)</Label>
+                                        <Input
+                                            value={(group.keywords || []).join(', ')}
+                                            onChange={(e) => updateKeywordGroup(index, { keywords: e.target.value.split(',').map((k) => k.trimStart()) })}
+                                            placeholder="bitcoin, ethereum"
+                                        />
+                                    </div>
+                                </div>
+                                <div className="grid grid-cols-2 sm:grid-cols-4 gap-3">
+                                    <div className="space-y-2">
+                                        <Label>Interval (secs)</Label>
+                                        <Input
+                                            type="number"
+                                            min={0}
+                                            value={group.intervalSecs || 0}
+                                            onChange={(e) => updateKeywordGroup(index, { intervalSecs: parseInt(e.target.value) || 0 })}
+                                        />
+                                    </div>
+                                    <div className="flex items-center gap-2 sm:col-span-2 pt-6">
+                                        <Checkbox
+                                            checked={!!group.filters}
+                                            onCheckedChange={(checked) => updateKeywordGroup(index, {
+                                                filters: checked ? {
+                                                    minFaves: formData.searchConfig?.minFaves || 0,
+                                                    minReplies: formData.searchConfig?.minReplies || 0,
+                                                    minRetweets: formData.searchConfig?.minRetweets || 0,
+                                                    maxAgeMins: formData.searchConfig?.maxAgeMins || 0,
+                                                } : undefined,
+                                            })}
+                                        />
+                                        <Label className="cursor-pointer">Own filters</Label>
+                                    </div>
+                                    <div className="flex items-end justify-end">
+                                        <Button
+                                            type="button"
+                                            variant="ghost"
+                                            size="icon"
+                                            onClick={() => removeKeywordGroup(index)}
+                                            className="h-9 w-9 text-destructive hover:text-destructive hover:bg-destructive/10"
+                                        >
+                                            <Trash2 size={16} />
+                                        </Button>
+                                    </div>
+                                </div>
+                                {group.filters && (
+                                    <div className="grid grid-cols-2 sm:grid-cols-4 gap-3">
+                                        {([
+                                            ['minFaves', 'Min Faves'],
+                                            ['minReplies', 'Min Replies'],
+                                            ['minRetweets', 'Min Retweets'],
+                                            ['maxAgeMins', 'Max Age (mins)'],
+                                        ] as [keyof SearchFilters, string][]).map(([key, label]) => (
+                                            <div key={key} className="space-y-2">
+                                                <Label>{label}</Label>
+                                                <Input
+                                                    type="number"
+                                                    min={0}
+                                                    value={group.filters?.[key] || 0}
+                                                    onChange={(e) => updateKeywordGroup(index, {
+                                                        filters: { ...group.filters!, [key]: parseInt(e.target.value) || 0 },
+                                                    })}
+                                                />
+                                            </div>
+                                        ))}
+                                    </div>
+                                )}
+                                <p className="text-xs text-muted-foreground">
+                                    Interval 0 searches the group on every run
+                                </p>
+                            </div>
+                        ))}
+                    </div>
+                )}
+            </div>
         </div>
     );
 
@@ -585,6 +888,144 @@ export default function AccountEditor({ account, isCreating, onSave, onClose, sh
                     />
                 </div>
             </div>
+
+            <div>
+                <h4 className="text-sm font-medium mb-4">Experiment</h4>
+                <div className="space-y-4">
+                    <div className="flex items-center justify-between p-4 bg-secondary/50 rounded-lg border border-border">
+                        <div>
+                            <p className="text-sm font-medium">A/B Test Personas and Prompts</p>
+                            <p className="text-xs text-muted-foreground">Split replies between variants by weight and compare their engagement on the Metrics page. The first variant is the baseline.</p>
+                        </div>
+                        <Switch
+                            checked={formData.experiment?.enabled || false}
+                            onCheckedChange={(checked) => handleChange('experiment.enabled', checked)}
+                        />
+                    </div>
+                    {formData.experiment?.enabled && (
+                        <div className="space-y-3">
+                            {(formData.experiment?.variants || []).map((variant, index) => (
+                                <div key={index} className="space-y-3 p-4 rounded-lg border border-border">
+                                    <div className="grid grid-cols-2 sm:grid-cols-4 gap-3">
+                                        <div className="space-y-2 sm:col-span-2">
+                                            <Label>Name</Label>
+                                            <Input
+                                                value={variant.name || ''}
+                                                onChange={(e) => updateVariant(index, { name: e.target.value })}
+                                                placeholder={index === 0 ? 'e.g. control' : 'e.g. questions'}
+                                            />
+                                        </div>
+                                        <div className="space-y-2">
+                                            <Label>Weight</Label>
+                                            <Input
+                                                type="number"
+                                                min={0}
+                                                value={variant.weight ?? 1}
+                                                onChange={(e) => updateVariant(index, { weight: parseInt(e.target.value) || 0 })}
+                                            />
+                                        </div>
+                                        <div className="flex items-end justify-end">
+                                            <Button
+                                                type="button"
+                                                variant="ghost"
+                                                size="icon"
+                                                onClick={() => removeVariant(index)}
+                                                className="h-9 w-9 text-destructive hover:text-destructive hover:bg-destructive/10"
+                                            >
+                                                <Trash2 size={16} />
+                                            </Button>
+                                        </div>
+                                    </div>
+                                    <div className="space-y-2">
+                                        <Label>Persona</Label>
+                                        <Textarea
+                                            value={variant.persona || ''}
+                                            onChange={(e) => updateVariant(index, { persona: e.target.value })}
+                                            placeholder="Account persona"
+                                            className="min-h-[60px]"
+                                        />
+                                    </div>
+                                    <div className="grid grid-cols-1 sm:grid-cols-3 gap-3">
+                                        <div className="space-y-2">
+                                            <Label>Tone</Label>
+                                            <Input
+                                                value={variant.tone || ''}
+                                                onChange={(e) => updateVariant(index, { tone: e.target.value })}
+                                                placeholder="Account tone"
+                                            />
+                                        </div>
+                                        <div className="space-y-2 sm:col-span-2">
+                                            <Label>Extra Instructions</Label>
+                                            <Input
+                                                value={variant.instructions || ''}
+                                                onChange={(e) => updateVariant(index, { instructions: e.target.value })}
+                                                placeholder="e.g. End with a question."
+                                            />
+                                        </div>
+                                    </div>
+                                </div>
+                            ))}
+                            <button
+                                type="button"
+                                onClick={addVariant}
+                                className="flex items-center gap-1 text-xs text-primary hover:text-primary/80 transition-colors"
+                            >
+                                <Plus size={14} /> Add Variant
+                            </button>
+                        </div>
+                    )}
+                </div>
+            </div>
+
+            <div>
+                <h4 className="text-sm font-medium mb-4">Relevance Filter</h4>
+                <div className="space-y-4">
+                    <div className="flex items-center justify-between p-4 bg-secondary/50 rounded-lg border border-border">
+                        <div>
+                            <p className="text-sm font-medium">Screen Found Tweets</p>
+                            <p className="text-xs text-muted-foreground">Ask the LLM how relevant each found tweet is to your topic and skip off-topic ones before replying.</p>
+                        </div>
+                        <Switch
+                            checked={formData.relevance?.enabled || false}
+                            onCheckedChange={(checked) => handleChange('relevance.enabled', checked)}
+                        />
+                    </div>
+                    {formData.relevance?.enabled && (
+                        <>
+                            <div className="space-y-2">
+                                <Label>Topic</Label>
+                                <Textarea
+                                    value={formData.relevance?.topic || ''}
+                                    onChange={(e) => handleChange('relevance.topic', e.target.value)}
+                                    placeholder="Describe what you want to reply to, e.g. developers discussing Go performance or tooling"
+                                    className="min-h-[80px]"
+                                />
+                            </div>
+                            <div className="grid grid-cols-1 sm:grid-cols-2 gap-4">
+                                <div className="space-y-2">
+                                    <Label>Min Relevance (0-1)</Label>
+                                    <Input
+                                        type="number"
+                                        step="0.05"
+                                        min="0"
+                                        max="1"
+                                        value={formData.relevance?.minScore ?? 0.5}
+                                        onChange={(e) => handleChange('relevance.minScore', parseFloat(e.target.value) || 0)}
+                                    />
+                                </div>
+                                <div className="space-y-2">
+                                    <Label>Classifier Model</Label>
+                                    <Input
+                                        value={formData.relevance?.model || ''}
+                                        onChange={(e) => handleChange('relevance.model', e.target.value)}
+                                        placeholder="Same as reply model"
+                                    />
+                                </div>
+                            </div>
+                        </>
+                    )}
+                </div>
+            </div>
         </div>
     );
 
@@ -625,6 +1066,29 @@ export default function AccountEditor({ account, isCreating, onSave, onClose, sh
                             onChange={(e) => handleChange('replyConfig.maxReplyLength', parseInt(e.target.value))}
                         />
                     </div>
+                    <div className="space-y-2">
+                        <Label>Reply Candidates</Label>
+                        <Input
+                            type="number"
+                            min={1}
+                            max={5}
+                            value={formData.replyConfig?.candidates || 1}
+                            onChange={(e) => handleChange('replyConfig.candidates', parseInt(e.target.value) || 1)}
+                        />
+                        <p className="text-xs text-muted-foreground">Queued replies offer this many variants to pick from</p>
+                    </div>
+                    {formData.replyConfig?.approvalMode === 'auto' && (
+                        <div className="space-y-2">
+                            <Label>Undo Window (seconds)</Label>
+                            <Input
+                                type="number"
+                                min={0}
+                                value={formData.replyConfig?.undoWindowSecs || 0}
+                                onChange={(e) => handleChange('replyConfig.undoWindowSecs', parseInt(e.target.value) || 0)}
+                            />
+                            <p className="text-xs text-muted-foreground">Auto-posted replies can be deleted from the activity feed for this long (0 = off)</p>
+                        </div>
+                    )}
                     <div className="flex items-center gap-3 p-4 bg-secondary/50 rounded-lg border border-border">
                         <Checkbox
                             checked={formData.replyConfig?.includeHashtags || false}
@@ -672,6 +1136,230 @@ export default function AccountEditor({ account, isCreating, onSave, onClose, sh
                     </div>
                 </div>
             </div>
+
+            <div>
+                <h4 className="text-sm font-medium mb-4">Posting Schedule</h4>
+                <div className="space-y-4">
+                    <div className="grid grid-cols-2 sm:grid-cols-4 gap-4">
+                        <div className="space-y-2">
+                            <Label>Active From (hour)</Label>
+                            <Input
+                                type="number"
+                                min={0}
+                                max={23}
+                                value={formData.posting?.activeStart ?? 0}
+                                onChange={(e) => handleChange('posting.activeStart', parseInt(e.target.value) || 0)}
+                            />
+                        </div>
+                        <div className="space-y-2">
+                            <Label>Active Until (hour)</Label>
+                            <Input
+                                type="number"
+                                min={0}
+                                max={23}
+                                value={formData.posting?.activeEnd ?? 0}
+                                onChange={(e) => handleChange('posting.activeEnd', parseInt(e.target.value) || 0)}
+                            />
+                        </div>
+                        <div className="space-y-2">
+                            <Label>Timezone</Label>
+                            <Input
+                                value={formData.posting?.timezone || ''}
+                                onChange={(e) => handleChange('posting.timezone', e.target.value)}
+                                placeholder="Local time"
+                            />
+                        </div>
+                        <div className="space-y-2">
+                            <Label>Jitter (secs)</Label>
+                            <Input
+                                type="number"
+                                min={0}
+                                value={formData.posting?.jitterSecs ?? 0}
+                                onChange={(e) => handleChange('posting.jitterSecs', parseInt(e.target.value) || 0)}
+                            />
+                        </div>
+                    </div>
+                    <p className="text-xs text-muted-foreground">
+                        Approved replies wait in a posting queue and go out only between these hours. Use the same hour twice to post around the clock.
+                    </p>
+                    <div className="flex items-center gap-3 p-4 bg-secondary/50 rounded-lg border border-border">
+                        <Checkbox
+                            checked={formData.posting?.spreadDaily || false}
+                            onCheckedChange={(checked) => handleChange('posting.spreadDaily', checked)}
+                        />
+                        <Label className="cursor-pointer">Spread replies/day evenly over active hours</Label>
+                    </div>
+                </div>
+            </div>
+
+            <div>
+                <h4 className="text-sm font-medium mb-4">Follow-ups</h4>
+                <div className="space-y-4">
+                    <div className="flex items-center justify-between p-4 bg-secondary/50 rounded-lg border border-border">
+                        <div>
+                            <p className="text-sm font-medium">Reply to Replies</p>
+                            <p className="text-xs text-muted-foreground">Watch mentions for replies to your replies and queue a follow-up for approval, with the conversation as context.</p>
+                        </div>
+                        <Switch
+                            checked={formData.followUps?.enabled || false}
+                            onCheckedChange={(checked) => handleChange('followUps.enabled', checked)}
+                        />
+                    </div>
+                    {formData.followUps?.enabled && (
+                        <div className="grid grid-cols-1 sm:grid-cols-2 gap-4">
+                            <div className="space-y-2">
+                                <Label>Max Depth</Label>
+                                <Input
+                                    type="number"
+                                    min={1}
+                                    value={formData.followUps?.maxDepth ?? 1}
+                                    onChange={(e) => handleChange('followUps.maxDepth', parseInt(e.target.value) || 1)}
+                                />
+                                <p className="text-xs text-muted-foreground">Follow-ups allowed in one conversation</p>
+                            </div>
+                            <div className="space-y-2">
+                                <Label>Check Interval (seconds, 0 = 5 min)</Label>
+                                <Input
+                                    type="number"
+                                    min={0}
+                                    value={formData.followUps?.intervalSecs ?? 0}
+                                    onChange={(e) => handleChange('followUps.intervalSecs', parseInt(e.target.value) || 0)}
+                                />
+                            </div>
+                        </div>
+                    )}
+                </div>
+            </div>
+
+            <div>
+                <h4 className="text-sm font-medium mb-4">Guardrails</h4>
+                <div className="space-y-4">
+                    <div className="flex items-center justify-between p-4 bg-secondary/50 rounded-lg border border-border">
+                        <div>
+                            <p className="text-sm font-medium">Check Replies Before Posting</p>
+                            <p className="text-xs text-muted-foreground">In auto mode, replies that fail a check go to the approval queue with the reason instead of being posted.</p>
+                        </div>
+                        <Switch
+                            checked={formData.guardrails?.enabled || false}
+                            onCheckedChange={(checked) => handleChange('guardrails.enabled', checked)}
+                        />
+                    </div>
+                    {formData.guardrails?.enabled && (
+                        <>
+                            <div className="grid grid-cols-1 sm:grid-cols-2 gap-4">
+                                <div className="space-y-2">
+                                    <Label>Banned Words</Label>
+                                    <Textarea
+                                        value={(formData.guardrails?.bannedWords || []).join('\n')}
+                                        onChange={(e) => handleChange('guardrails.bannedWords', e.target.value.split('\n'))}
+                                        placeholder="One word or phrase per line"
+                                        className="min-h-[80px]"
+                                    />
+                                </div>
+                                <div className="space-y-2">
+                                    <Label>Banned Patterns</Label>
+                                    <Textarea
+                                        value={(formData.guardrails?.bannedPatterns || []).join('\n')}
+                                        onChange={(e) => handleChange('guardrails.bannedPatterns', e.target.value.split('\n'))}
+                                        placeholder="One regular expression per line, e.g. (?i)\bguarantee"
+                                        className="min-h-[80px] font-mono"
+                                    />
+                                </div>
+                                <div className="space-y-2">
+                                    <Label>Max Hashtags (0 = no limit)</Label>
+                                    <Input
+                                        type="number"
+                                        min={0}
+                                        value={formData.guardrails?.maxHashtags ?? 0}
+                                        onChange={(e) => handleChange('guardrails.maxHashtags', parseInt(e.target.value) || 0)}
+                                    />
+                                </div>
+                                <div className="space-y-2">
+                                    <Label>Duplicate Similarity (0-1, 0 = off)</Label>
+                                    <Input
+                                        type="number"
+                                        step="0.05"
+                                        min="0"
+                                        max="1"
+                                        value={formData.guardrails?.duplicateThreshold ?? 0}
+                                        onChange={(e) => handleChange('guardrails.duplicateThreshold', parseFloat(e.target.value) || 0)}
+                                    />
+                                </div>
+                            </div>
+                            <div className="grid grid-cols-1 sm:grid-cols-3 gap-4">
+                                <div className="flex items-center gap-3 p-4 bg-secondary/50 rounded-lg border border-border">
+                                    <Checkbox
+                                        checked={formData.guardrails?.allowLinks || false}
+                                        onCheckedChange={(checked) => handleChange('guardrails.allowLinks', checked)}
+                                    />
+                                    <Label className="cursor-pointer">Allow links</Label>
+                                </div>
+                                <div className="flex items-center gap-3 p-4 bg-secondary/50 rounded-lg border border-border">
+                                    <Checkbox
+                                        checked={formData.guardrails?.allowMentions || false}
+                                        onCheckedChange={(checked) => handleChange('guardrails.allowMentions', checked)}
+                                    />
+                                    <Label className="cursor-pointer">Allow other @mentions</Label>
+                                </div>
+                                <div className="flex items-center gap-3 p-4 bg-secondary/50 rounded-lg border border-border">
+                                    <Checkbox
+                                        checked={formData.guardrails?.llmModeration || false}
+                                        onCheckedChange={(checked) => handleChange('guardrails.llmModeration', checked)}
+                                    />
+                                    <Label className="cursor-pointer">LLM moderation</Label>
+                                </div>
+                            </div>
+                        </>
+                    )}
+                </div>
+            </div>
+
+            <div>
+                <h4 className="text-sm font-medium mb-4">Polymarket Alert Tweets</h4>
+                <div className="space-y-4">
+                    <div className="flex items-center justify-between p-4 bg-secondary/50 rounded-lg border border-border">
+                        <div>
+                            <p className="text-sm font-medium">Tweet Fresh Wallet Trades</p>
+                            <p className="text-xs text-muted-foreground">Draft tweets from this account when a fresh wallet places a big trade. Uses the approval mode above.</p>
+                        </div>
+                        <Switch
+                            checked={formData.alertBridge?.enabled || false}
+                            onCheckedChange={(checked) => handleChange('alertBridge.enabled', checked)}
+                        />
+                    </div>
+                    {formData.alertBridge?.enabled && (
+                        <>
+                            <div className="grid grid-cols-1 sm:grid-cols-2 gap-4">
+                                <div className="space-y-2">
+                                    <Label>Min Trade Value ($)</Label>
+                                    <Input
+                                        type="number"
+                                        value={formData.alertBridge?.minTradeValue ?? 10000}
+                                        onChange={(e) => handleChange('alertBridge.minTradeValue', parseFloat(e.target.value) || 0)}
+                                    />
+                                </div>
+                                <div className="space-y-2">
+                                    <Label>Tweets/Day</Label>
+                                    <Input
+                                        type="number"
+                                        value={formData.alertBridge?.dailyCap ?? 5}
+                                        onChange={(e) => handleChange('alertBridge.dailyCap', parseInt(e.target.value) || 0)}
+                                    />
+                                </div>
+                            </div>
+                            <div className="space-y-2">
+                                <Label>Prompt Template</Label>
+                                <Textarea
+                                    value={formData.alertBridge?.template || ''}
+                                    onChange={(e) => handleChange('alertBridge.template', e.target.value)}
+                                    placeholder="Leave empty to use the default prompt. Available: {{.Event.EventTitle}}, {{.Event.Outcome}}, {{.Side}}, {{money .Notional}}, {{shortenAddr .Event.WalletAddress}}, {{.Wallet.BetCount}}, {{marketLink .Event}}"
+                                    className="min-h-[120px] font-mono text-xs"
+                                />
+                            </div>
+                        </>
+                    )}
+                </div>
+            </div>
         </div>
     );
 
@@ -0,0 +1,111 @@
+import { useEffect, useState } from 'react';
+import { RefreshCw, AlertTriangle } from 'lucide-react';
+import Card from './common/Card';
+import Button from './common/Button';
+import {
+    Select,
+    SelectContent,
+    SelectItem,
+    SelectTrigger,
+    SelectValue,
+} from './ui';
+import { KeywordStats as KeywordStatsRow } from '../types';
+import { GetKeywordStats } from '../../wailsjs/go/main/App';
+
+// Keywords that found this many tweets without a posted reply are flagged
+const DEAD_KEYWORD_MIN_FOUND = 20;
+
+interface KeywordStatsProps {
+    accountId: string;
+}
+
+export default function KeywordStats({ accountId }: KeywordStatsProps) {
+    const [stats, setStats] = useState<KeywordStatsRow[]>([]);
+    const [days, setDays] = useState(30);
+    const [isLoading, setIsLoading] = useState(false);
+
+    const loadStats = async () => {
+        setIsLoading(true);
+        try {
+            const result = await GetKeywordStats(accountId, days);
+            setStats((result || []) as KeywordStatsRow[]);
+        } catch (err) {
+            setStats([]);
+        } finally {
+            setIsLoading(false);
+        }
+    };
+
+    useEffect(() => {
+        loadStats();
+    }, [accountId, days]);
+
+    const isDead = (s: KeywordStatsRow) => s.tweetsFound >= DEAD_KEYWORD_MIN_FOUND && s.repliesPosted === 0;
+
+    return (
+        <Card
+            title="Keyword Performance"
+            actions={
+                <>
+                    <Select value={String(days)} onValueChange={(v) => setDays(parseInt(v))}>
+                        <SelectTrigger className="w-[120px] h-8">
+                            <SelectValue />
+                        </SelectTrigger>
+                        <SelectContent>
+                            <SelectItem value="7">Last 7 days</SelectItem>
+                            <SelectItem value="30">Last 30 days</SelectItem>
+                            <SelectItem value="90">Last 90 days</SelectItem>
+                        </SelectContent>
+                    </Select>
+                    <Button variant="ghost" size="sm" onClick={loadStats} disabled={isLoading}>
+                        <RefreshCw size={14} className={isLoading ? 'animate-spin' : ''} />
+                    </Button>
+                </>
+            }
+        >
+            {stats.length === 0 ? (
+                <p className="text-muted-foreground text-center py-8">
+                    No keyword data yet. Stats appear after searches find tweets.
+                </p>
+            ) : (
+                <div className="overflow-x-auto">
+                    <table className="w-full text-sm">
+                        <thead>
+                            <tr className="text-left text-muted-foreground border-b border-border">
+                                <th className="py-2 pr-4 font-medium">Keyword</th>
+                                <th className="py-2 pr-4 font-medium text-right">Found</th>
+                                <th className="py-2 pr-4 font-medium text-right">Generated</th>
+                                <th className="py-2 pr-4 font-medium text-right">Posted</th>
+                                <th className="py-2 pr-4 font-medium text-right">Likes</th>
+                                <th className="py-2 pr-4 font-medium text-right">Impressions</th>
+                                <th className="py-2 font-medium text-right">Conversion</th>
+                            </tr>
+                        </thead>
+                        <tbody>
+                            {stats.map((s) => (
+                                <tr key={s.keyword} className="border-b border-border/50">
+                                    <td className="py-2 pr-4">
+                                        <div className="flex items-center gap-2">
+                                            {isDead(s) && (
+                                                <span title="Finds tweets but never leads to a posted reply">
+                                                    <AlertTriangle size={14} className="text-yellow-400" />
+                                                </span>
+                                            )}
+                                            <span className="font-medium">{s.keyword}</span>
+                                        </div>
+                                    </td>
+                                    <td className="py-2 pr-4 text-right">{s.tweetsFound}</td>
+                                    <td className="py-2 pr-4 text-right">{s.repliesGenerated}</td>
+                                    <td className="py-2 pr-4 text-right">{s.repliesPosted}</td>
+                                    <td className="py-2 pr-4 text-right">{s.likes}</td>
+                                    <td className="py-2 pr-4 text-right">{s.impressions.toLocaleString()}</td>
+                                    <td className="py-2 text-right">{(s.conversionRate * 100).toFixed(1)}%</td>
+                                </tr>
+                            ))}
+                        </tbody>
+                    </table>
+                </div>
+            )}
+        </Card>
+    );
+}
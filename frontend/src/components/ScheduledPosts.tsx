@@ -0,0 +1,304 @@
+import { useEffect, useState } from 'react';
+import { CalendarClock, Plus, Send, Trash2, X, ExternalLink } from 'lucide-react';
+import Card from './common/Card';
+import Button from './common/Button';
+import {
+    Input,
+    Label,
+    Select,
+    SelectContent,
+    SelectItem,
+    SelectTrigger,
+    SelectValue,
+    Textarea,
+} from './ui';
+import { ScheduledPost, ScheduledPostType } from '../types';
+import {
+    SchedulePost,
+    GetScheduledPosts,
+    CancelScheduledPost,
+    DeleteScheduledPost,
+    PublishScheduledPost,
+} from '../../wailsjs/go/main/App';
+import { EventsOn } from '../../wailsjs/runtime/runtime';
+
+const MAX_TWEET_LENGTH = 280;
+
+interface ScheduledPostsProps {
+    accountId: string;
+    showToast: (message: string, type: 'success' | 'error' | 'info' | 'warning') => void;
+}
+
+// toLocalInput formats a date for a datetime-local input
+const toLocalInput = (d: Date) => {
+    const pad = (n: number) => n.toString().padStart(2, '0');
+    return `${d.getFullYear()}-${pad(d.getMonth() + 1)}-${pad(d.getDate())}T${pad(d.getHours())}:${pad(d.getMinutes())}`;
+};
+
+const errorMessage = (err: any, fallback: string) =>
+    typeof err === 'string' ? err : (err?.message || fallback);
+
+export default function ScheduledPosts({ accountId, showToast }: ScheduledPostsProps) {
+    const [posts, setPosts] = useState<ScheduledPost[]>([]);
+    const [type, setType] = useState<ScheduledPostType>('tweet');
+    const [texts, setTexts] = useState<string[]>(['']);
+    const [quoteTweetId, setQuoteTweetId] = useState('');
+    const [scheduledAt, setScheduledAt] = useState('');
+    const [isSaving, setIsSaving] = useState(false);
+
+    const loadPosts = async () => {
+        try {
+            const result = await GetScheduledPosts(accountId, 100);
+            setPosts((result || []) as ScheduledPost[]);
+        } catch (err) {
+            // Silent fail for background refresh
+        }
+    };
+
+    useEffect(() => {
+        loadPosts();
+        const offs = ['post:scheduled', 'post:published', 'post:failed'].map((name) =>
+            EventsOn(name, (post: ScheduledPost) => {
+                if (post?.accountId === accountId) loadPosts();
+            })
+        );
+        return () => offs.forEach((off) => off());
+    }, [accountId]);
+
+    const changeType = (value: ScheduledPostType) => {
+        setType(value);
+        if (value === 'thread' && texts.length < 2) {
+            setTexts([...texts, '']);
+        } else if (value !== 'thread') {
+            setTexts([texts[0] || '']);
+        }
+    };
+
+    const updateText = (index: number, value: string) => {
+        const next = [...texts];
+        next[index] = value;
+        setTexts(next);
+    };
+
+    const resetForm = () => {
+        setType('tweet');
+        setTexts(['']);
+        setQuoteTweetId('');
+        setScheduledAt('');
+    };
+
+    const handleSchedule = async () => {
+        setIsSaving(true);
+        try {
+            await SchedulePost({
+                accountId,
+                type,
+                texts,
+                quoteTweetId: type === 'quote' ? quoteTweetId : '',
+                // Empty time publishes on the next check
+                scheduledAt: scheduledAt ? new Date(scheduledAt).toISOString() : '0001-01-01T00:00:00Z',
+            } as any);
+            showToast(scheduledAt ? 'Post scheduled' : 'Post queued for publishing', 'success');
+            resetForm();
+            loadPosts();
+        } catch (err: any) {
+            showToast(errorMessage(err, 'Failed to schedule post'), 'error');
+        } finally {
+            setIsSaving(false);
+        }
+    };
+
+    const runAction = async (action: () => Promise<void>, success: string, failure: string) => {
+        try {
+            await action();
+            showToast(success, 'success');
+        } catch (err: any) {
+            showToast(errorMessage(err, failure), 'error');
+        } finally {
+            loadPosts();
+        }
+    };
+
+    const tooLong = texts.some((t) => t.length > MAX_TWEET_LENGTH);
+    const canSubmit = texts.every((t) => t.trim() !== '') && !tooLong && (type !== 'quote' || quoteTweetId.trim() !== '');
+
+    return (
+        <div className="space-y-4">
+            <Card title="New Post">
+                <div className="space-y-4">
+                    <div className="grid grid-cols-1 sm:grid-cols-2 gap-4">
+                        <div className="space-y-2">
+                            <Label>Type</Label>
+                            <Select value={type} onValueChange={(v) => changeType(v as ScheduledPostType)}>
+                                <SelectTrigger>
+                                    <SelectValue />
+                                </SelectTrigger>
+                                <SelectContent>
+                                    <SelectItem value="tweet">Tweet</SelectItem>
+                                    <SelectItem value="quote">Quote Tweet</SelectItem>
+                                    <SelectItem value="thread">Thread</SelectItem>
+                                </SelectContent>
+                            </Select>
+                        </div>
+                        <div className="space-y-2">
+                            <Label>Publish At</Label>
+                            <Input
+                                type="datetime-local"
+                                value={scheduledAt}
+                                min={toLocalInput(new Date())}
+                                onChange={(e) => setScheduledAt(e.target.value)}
+                            />
+                            <p className="text-xs text-muted-foreground">Leave empty to publish right away</p>
+                        </div>
+                    </div>
+
+                    {type === 'quote' && (
+                        <div className="space-y-2">
+                            <Label>Tweet to Quote</Label>
+                            <Input
+                                value={quoteTweetId}
+                                onChange={(e) => setQuoteTweetId(e.target.value)}
+                                placeholder="Tweet ID or https://x.com/user/status/..."
+                            />
+                        </div>
+                    )}
+
+                    {texts.map((text, i) => (
+                        <div key={i} className="space-y-1">
+                            <div className="flex items-center justify-between">
+                                <Label>{type === 'thread' ? `Tweet ${i + 1}` : 'Text'}</Label>
+                                <div className="flex items-center gap-2">
+                                    <span className={`text-xs ${text.length > MAX_TWEET_LENGTH ? 'text-red-400' : 'text-muted-foreground'}`}>
+                                        {text.length}/{MAX_TWEET_LENGTH}
+                                    </span>
+                                    {type === 'thread' && texts.length > 2 && (
+                                        <Button variant="ghost" size="sm" onClick={() => setTexts(texts.filter((_, j) => j !== i))}>
+                                            <X size={14} />
+                                        </Button>
+                                    )}
+                                </div>
+                            </div>
+                            <Textarea
+                                value={text}
+                                onChange={(e) => updateText(i, e.target.value)}
+                                className="min-h-[80px]"
+                            />
+                        </div>
+                    ))}
+
+                    <div className="flex items-center justify-between">
+                        {type === 'thread' ? (
+                            <Button variant="ghost" size="sm" onClick={() => setTexts([...texts, ''])}>
+                                <Plus size={14} />
+                                Add Tweet
+                            </Button>
+                        ) : <span />}
+                        <Button onClick={handleSchedule} disabled={!canSubmit || isSaving} loading={isSaving}>
+                            <CalendarClock size={16} />
+                            {scheduledAt ? 'Schedule' : 'Publish'}
+                        </Button>
+                    </div>
+                </div>
+            </Card>
+
+            <Card title={`Scheduled Posts (${posts.length})`}>
+                {posts.length === 0 ? (
+                    <p className="text-muted-foreground text-center py-8">
+                        No scheduled posts yet.
+                    </p>
+                ) : (
+                    <div className="space-y-3 max-h-[500px] overflow-y-auto">
+                        {posts.map((post) => (
+                            <ScheduledPostCard
+                                key={post.id}
+                                post={post}
+                                onPublish={() => runAction(() => PublishScheduledPost(post.id), 'Post published', 'Failed to publish post')}
+                                onCancel={() => runAction(() => CancelScheduledPost(post.id), 'Post cancelled', 'Failed to cancel post')}
+                                onDelete={() => runAction(() => DeleteScheduledPost(post.id), 'Post deleted', 'Failed to delete post')}
+                            />
+                        ))}
+                    </div>
+                )}
+            </Card>
+        </div>
+    );
+}
+
+// Scheduled Post Card Component
+function ScheduledPostCard({ post, onPublish, onCancel, onDelete }: {
+    post: ScheduledPost;
+    onPublish: () => void;
+    onCancel: () => void;
+    onDelete: () => void;
+}) {
+    const statusColors: Record<string, string> = {
+        scheduled: 'bg-blue-600/20 text-blue-400',
+        posting: 'bg-yellow-600/20 text-yellow-400',
+        posted: 'bg-green-600/20 text-green-400',
+        failed: 'bg-red-600/20 text-red-400',
+        cancelled: 'bg-muted text-muted-foreground',
+    };
+
+    return (
+        <div className="p-3 bg-secondary/50 rounded-lg">
+            <div className="flex items-center gap-2 mb-2">
+                <span className={`px-2 py-0.5 text-xs rounded ${statusColors[post.status] || ''}`}>
+                    {post.status}
+                </span>
+                <span className="text-xs text-muted-foreground">{post.type}</span>
+                <span className="text-xs text-muted-foreground ml-auto">
+                    {post.postedAt
+                        ? new Date(post.postedAt).toLocaleString()
+                        : new Date(post.scheduledAt).toLocaleString()}
+                </span>
+            </div>
+            {post.quoteTweetId && (
+                <p className="text-xs text-muted-foreground mb-1">Quoting {post.quoteTweetId}</p>
+            )}
+            <div className="space-y-1">
+                {(post.texts || []).map((text, i) => (
+                    <p key={i} className="text-sm whitespace-pre-line">
+                        {post.type === 'thread' && <span className="text-muted-foreground">{i + 1}/ </span>}
+                        {text}
+                    </p>
+                ))}
+            </div>
+            {post.errorMessage && (
+                <p className="text-red-400 text-xs mt-1">{post.errorMessage}</p>
+            )}
+            <div className="flex items-center gap-2 mt-2">
+                {post.postedIds && post.postedIds.length > 0 && (
+                    <a
+                        href={`https://x.com/i/web/status/${post.postedIds[0]}`}
+                        target="_blank"
+                        rel="noopener noreferrer"
+                        className="text-primary text-xs hover:underline flex items-center gap-1"
+                    >
+                        <ExternalLink size={12} />
+                        View on X
+                    </a>
+                )}
+                <div className="ml-auto flex items-center gap-2">
+                    {post.status === 'scheduled' && (
+                        <>
+                            <Button variant="ghost" size="sm" onClick={onCancel}>
+                                <X size={14} />
+                                Cancel
+                            </Button>
+                            <Button size="sm" onClick={onPublish}>
+                                <Send size={14} />
+                                Publish Now
+                            </Button>
+                        </>
+                    )}
+                    {post.status !== 'scheduled' && post.status !== 'posting' && (
+                        <Button variant="ghost" size="sm" onClick={onDelete}>
+                            <Trash2 size={14} />
+                            Delete
+                        </Button>
+                    )}
+                </div>
+            </div>
+        </div>
+    );
+}
@@ -12,6 +12,7 @@ import {
   RefreshCw,
   ExternalLink,
   Sparkles,
+  Inbox,
 } from 'lucide-react';
 import {
   Sidebar,
@@ -35,6 +36,7 @@ const navItems = [
   { to: '/', icon: LayoutDashboard, label: 'Dashboard', end: true },
   { to: '/accounts', icon: Users, label: 'Accounts' },
   { to: '/search', icon: Search, label: 'Search' },
+  { to: '/approvals', icon: Inbox, label: 'Approvals' },
   { to: '/metrics', icon: BarChart3, label: 'Metrics' },
 ];
 
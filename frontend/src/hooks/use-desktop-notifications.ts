@@ -0,0 +1,37 @@
+import * as React from "react"
+import { EventsOn, EventsOff, BrowserOpenURL } from "../../wailsjs/runtime/runtime"
+import type { DesktopNotificationEvent } from "../types"
+
+const DESKTOP_NOTIFICATION_EVENT = "notification:desktop"
+
+// Shows backend desktop notification events through the OS notification center
+export function useDesktopNotifications() {
+  React.useEffect(() => {
+    if (typeof Notification === "undefined") {
+      return
+    }
+    if (Notification.permission === "default") {
+      Notification.requestPermission()
+    }
+
+    EventsOn(DESKTOP_NOTIFICATION_EVENT, (event: DesktopNotificationEvent) => {
+      if (Notification.permission !== "granted") {
+        return
+      }
+      const notification = new Notification(event.title, {
+        body: event.body,
+        tag: event.link || undefined,
+        requireInteraction: event.priority === "high",
+      })
+      notification.onclick = () => {
+        window.focus()
+        if (event.link) {
+          BrowserOpenURL(event.link)
+        }
+        notification.close()
+      }
+    })
+
+    return () => EventsOff(DESKTOP_NOTIFICATION_EVENT)
+  }, [])
+}
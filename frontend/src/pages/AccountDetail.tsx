@@ -15,15 +15,27 @@ import {
     AlertCircle,
     AlertTriangle,
     Info,
+    Paperclip,
+    Image as ImageIcon,
+    Undo2,
 } from 'lucide-react';
 import Card from '../components/common/Card';
 import Button from '../components/common/Button';
+import ConfirmModal from '../components/common/ConfirmModal';
 import { Badge } from '../components/ui/badge';
+import {
+    Select,
+    SelectContent,
+    SelectItem,
+    SelectTrigger,
+    SelectValue,
+} from '../components/ui/select';
 import { Tabs, TabsContent, TabsList, TabsTrigger } from '../components/ui/tabs';
+import { Textarea } from '../components/ui/textarea';
 import { useAccountStore } from '../store/accountStore';
 import { useReplyStore } from '../store/replyStore';
 import { useUIStore } from '../store/uiStore';
-import { AccountConfig, ActivityLog, ApprovalQueueItem, Reply } from '../types';
+import { AccountConfig, ActivityLog, ApprovalQueueItem, MediaAttachment, Reply } from '../types';
 import {
     GetAccounts,
     GetWorkerStatus,
@@ -35,8 +47,16 @@ import {
     GetReplyHistory,
     ApproveReply,
     RejectReply,
+    SetReplyMedia,
+    SelectMediaFiles,
+    SelectReplyCandidate,
+    EditReply,
+    PostReplyNow,
+    DeleteReply,
 } from '../../wailsjs/go/main/App';
 import AccountEditor from '../components/AccountEditor';
+import ScheduledPosts from '../components/ScheduledPosts';
+import KeywordStats from '../components/KeywordStats';
 
 export default function AccountDetail() {
     const { accountId } = useParams<{ accountId: string }>();
@@ -47,11 +67,13 @@ export default function AccountDetail() {
 
     const [account, setAccount] = useState<AccountConfig | null>(null);
     const [logs, setLogs] = useState<ActivityLog[]>([]);
+    const [deleteReplyId, setDeleteReplyId] = useState<string | null>(null);
     const [replyHistory, setReplyHistory] = useState<Reply[]>([]);
     const [isLoading, setIsLoading] = useState(false);
     const [autoRefresh, setAutoRefresh] = useState(true);
     const [editingAccount, setEditingAccount] = useState<AccountConfig | null>(null);
     const [activeTab, setActiveTab] = useState('actions');
+    const [pendingSort, setPendingSort] = useState<PendingSort>('queued');
 
     useEffect(() => {
         loadData();
@@ -155,7 +177,7 @@ export default function AccountDetail() {
             if (accountId) {
                 removePendingReply(accountId, replyId);
             }
-            showToast('Reply approved and posted', 'success');
+            showToast('Reply approved and scheduled', 'success');
             loadReplies();
         } catch (err: any) {
             const errorMsg = typeof err === 'string' ? err : (err?.message || 'Failed to approve reply');
@@ -177,6 +199,65 @@ export default function AccountDetail() {
         }
     };
 
+    const handleMediaChange = async (replyId: string, media: MediaAttachment[]) => {
+        try {
+            await SetReplyMedia(replyId, media);
+            await loadReplies();
+        } catch (err: any) {
+            const errorMsg = typeof err === 'string' ? err : (err?.message || 'Failed to update attachments');
+            showToast(errorMsg, 'error');
+        }
+    };
+
+    const handlePostNow = async (replyId: string) => {
+        try {
+            await PostReplyNow(replyId);
+            showToast('Reply will post within a few seconds', 'info');
+            loadReplies();
+        } catch (err: any) {
+            const errorMsg = typeof err === 'string' ? err : (err?.message || 'Failed to post reply');
+            showToast(errorMsg, 'error');
+        }
+    };
+
+    const handleDeleteReply = async (replyId: string) => {
+        try {
+            await DeleteReply(replyId);
+            showToast('Reply deleted from X', 'success');
+            await Promise.all([loadLogs(), loadReplies()]);
+        } catch (err: any) {
+            const errorMsg = typeof err === 'string' ? err : (err?.message || 'Failed to delete reply');
+            showToast(errorMsg, 'error');
+        }
+    };
+
+    const confirmDeleteReply = async () => {
+        if (!deleteReplyId) return;
+        const replyId = deleteReplyId;
+        setDeleteReplyId(null);
+        await handleDeleteReply(replyId);
+    };
+
+    const handleSelectCandidate = async (replyId: string, index: number) => {
+        try {
+            await SelectReplyCandidate(replyId, index);
+            await loadReplies();
+        } catch (err: any) {
+            const errorMsg = typeof err === 'string' ? err : (err?.message || 'Failed to select candidate');
+            showToast(errorMsg, 'error');
+        }
+    };
+
+    const handleEditReply = async (replyId: string, text: string) => {
+        try {
+            await EditReply(replyId, text);
+            await loadReplies();
+        } catch (err: any) {
+            const errorMsg = typeof err === 'string' ? err : (err?.message || 'Failed to save reply');
+            showToast(errorMsg, 'error');
+        }
+    };
+
     const handleSaveAccount = async () => {
         setEditingAccount(null);
         await loadData();
@@ -190,7 +271,7 @@ export default function AccountDetail() {
         );
     }
 
-    const currentPending = accountId ? pendingReplies[accountId] || [] : [];
+    const currentPending = sortPending(accountId ? pendingReplies[accountId] || [] : [], pendingSort);
     const isRunning = accountId ? workerStatuses[accountId] : false;
 
     return (
@@ -228,7 +309,7 @@ export default function AccountDetail() {
 
             {/* Tabs */}
             <Tabs value={activeTab} onValueChange={setActiveTab} className="w-full">
-                <TabsList className="grid w-full grid-cols-3">
+                <TabsList className="grid w-full grid-cols-5">
                     <TabsTrigger value="actions">Actions</TabsTrigger>
                     <TabsTrigger value="logs">
                         Logs {logs.length > 0 && <span className="ml-1 text-xs">({logs.length})</span>}
@@ -236,6 +317,8 @@ export default function AccountDetail() {
                     <TabsTrigger value="replies">
                         Replies {currentPending.length > 0 && <span className="ml-1 text-xs text-yellow-500">({currentPending.length})</span>}
                     </TabsTrigger>
+                    <TabsTrigger value="posts">Posts</TabsTrigger>
+                    <TabsTrigger value="keywords">Keywords</TabsTrigger>
                 </TabsList>
 
                 {/* Actions Tab */}
@@ -339,7 +422,7 @@ export default function AccountDetail() {
                         ) : (
                             <div className="space-y-2 max-h-[500px] overflow-y-auto">
                                 {logs.map((log) => (
-                                    <LogItem key={log.id} log={log} />
+                                    <LogItem key={log.id} log={log} onUndo={() => handleDeleteReply(log.replyId!)} />
                                 ))}
                             </div>
                         )}
@@ -349,7 +432,22 @@ export default function AccountDetail() {
                 {/* Replies Tab */}
                 <TabsContent value="replies" className="space-y-4 mt-4">
                     {/* Pending Replies */}
-                    <Card title={`Pending Approvals (${currentPending.length})`}>
+                    <Card
+                        title={`Pending Approvals (${currentPending.length})`}
+                        actions={
+                            <Select value={pendingSort} onValueChange={(v) => setPendingSort(v as PendingSort)}>
+                                <SelectTrigger className="w-[160px]">
+                                    <SelectValue />
+                                </SelectTrigger>
+                                <SelectContent>
+                                    <SelectItem value="queued">Queue order</SelectItem>
+                                    <SelectItem value="priority">Priority</SelectItem>
+                                    <SelectItem value="relevance">Most relevant</SelectItem>
+                                    <SelectItem value="author">Author score</SelectItem>
+                                </SelectContent>
+                            </Select>
+                        }
+                    >
                         {currentPending.length === 0 ? (
                             <p className="text-muted-foreground text-center py-8">
                                 No pending replies. Replies awaiting approval will appear here.
@@ -362,6 +460,9 @@ export default function AccountDetail() {
                                         item={item}
                                         onApprove={() => handleApprove(item.reply.id)}
                                         onReject={() => handleReject(item.reply.id)}
+                                        onMediaChange={(media) => handleMediaChange(item.reply.id, media)}
+                                        onSelectCandidate={(index) => handleSelectCandidate(item.reply.id, index)}
+                                        onEdit={(text) => handleEditReply(item.reply.id, text)}
                                     />
                                 ))}
                             </div>
@@ -377,12 +478,27 @@ export default function AccountDetail() {
                         ) : (
                             <div className="space-y-3 max-h-[400px] overflow-y-auto">
                                 {replyHistory.map((reply) => (
-                                    <ReplyHistoryCard key={reply.id} reply={reply} />
+                                    <ReplyHistoryCard
+                                        key={reply.id}
+                                        reply={reply}
+                                        onPostNow={() => handlePostNow(reply.id)}
+                                        onDelete={() => setDeleteReplyId(reply.id)}
+                                    />
                                 ))}
                             </div>
                         )}
                     </Card>
                 </TabsContent>
+
+                {/* Posts Tab */}
+                <TabsContent value="posts" className="mt-4">
+                    <ScheduledPosts accountId={account.id} showToast={showToast} />
+                </TabsContent>
+
+                {/* Keywords Tab */}
+                <TabsContent value="keywords" className="mt-4">
+                    <KeywordStats accountId={account.id} />
+                </TabsContent>
             </Tabs>
 
             {/* Account Editor Modal */}
@@ -395,12 +511,34 @@ export default function AccountDetail() {
                     showToast={showToast}
                 />
             )}
+
+            {/* Delete Reply Confirmation Modal */}
+            <ConfirmModal
+                isOpen={deleteReplyId !== null}
+                title="Delete Reply"
+                message="Delete this reply from X? This action cannot be undone."
+                confirmText="Delete"
+                cancelText="Cancel"
+                variant="danger"
+                onConfirm={confirmDeleteReply}
+                onCancel={() => setDeleteReplyId(null)}
+            />
         </div>
     );
 }
 
 // Log Item Component
-function LogItem({ log }: { log: ActivityLog }) {
+function LogItem({ log, onUndo }: { log: ActivityLog; onUndo: () => void }) {
+    const [, setTick] = useState(0);
+    const undoLeft = log.undoUntil ? Math.ceil((new Date(log.undoUntil).getTime() - Date.now()) / 1000) : 0;
+
+    // Re-render each second so the undo countdown runs and the button disappears
+    useEffect(() => {
+        if (undoLeft <= 0) return;
+        const timer = setTimeout(() => setTick((t) => t + 1), 1000);
+        return () => clearTimeout(timer);
+    }, [undoLeft]);
+
     const getLevelIcon = (level: string) => {
         switch (level) {
             case 'success':
@@ -435,6 +573,7 @@ function LogItem({ log }: { log: ActivityLog }) {
             error: 'bg-red-600/20 text-red-400',
             worker: 'bg-yellow-600/20 text-yellow-400',
             config: 'bg-muted text-muted-foreground',
+            post: 'bg-cyan-600/20 text-cyan-400',
         };
         return colors[type] || 'bg-muted text-muted-foreground';
     };
@@ -457,39 +596,193 @@ function LogItem({ log }: { log: ActivityLog }) {
                         <p className="text-xs text-muted-foreground mt-1">{log.details}</p>
                     )}
                 </div>
+                {log.replyId && undoLeft > 0 && (
+                    <Button variant="ghost" size="sm" onClick={onUndo}>
+                        <Undo2 size={14} />
+                        Undo ({undoLeft}s)
+                    </Button>
+                )}
             </div>
         </div>
     );
 }
 
 // Approval Card Component
-function ApprovalCard({ item, onApprove, onReject }: {
+type PendingSort = 'queued' | 'priority' | 'relevance' | 'author';
+
+// sortPending orders the approval queue; unscored tweets go last
+function sortPending(items: ApprovalQueueItem[], sort: PendingSort): ApprovalQueueItem[] {
+    if (sort === 'queued') {
+        return items;
+    }
+    const score = (item: ApprovalQueueItem) => {
+        switch (sort) {
+            case 'priority':
+                return item.priority;
+            case 'relevance':
+                return item.originalTweet.relevance ?? -1;
+            default:
+                return item.originalTweet.authorScore ?? -1;
+        }
+    };
+    return [...items].sort((a, b) => score(b) - score(a));
+}
+
+function ApprovalCard({ item, onApprove, onReject, onMediaChange, onSelectCandidate, onEdit }: {
     item: ApprovalQueueItem;
     onApprove: () => void;
     onReject: () => void;
+    onMediaChange: (media: MediaAttachment[]) => Promise<void>;
+    onSelectCandidate: (index: number) => Promise<void>;
+    onEdit: (text: string) => Promise<void>;
 }) {
+    const isTweet = item.reply.type === 'tweet';
+    const media = item.reply.media || [];
+    const candidates = item.reply.candidates || [];
+    const [altTexts, setAltTexts] = useState<Record<string, string>>({});
+    const [draft, setDraft] = useState(item.reply.text);
+
+    useEffect(() => {
+        setDraft(item.reply.text);
+    }, [item.reply.text]);
+
+    const handleDraftSave = () => {
+        if (draft.trim() && draft !== item.reply.text) {
+            onEdit(draft);
+        }
+    };
+
+    const handleAttach = async () => {
+        let paths: string[] = [];
+        try {
+            paths = (await SelectMediaFiles()) || [];
+        } catch {
+            return; // Dialog closed
+        }
+        if (paths.length === 0) return;
+        const existing = new Set(media.map((m) => m.path));
+        const added = paths.filter((p) => !existing.has(p)).map((path) => ({ path }));
+        await onMediaChange([...media, ...added]);
+    };
+
+    const handleRemove = (path: string) => onMediaChange(media.filter((m) => m.path !== path));
+
+    const handleAltTextSave = (path: string) => {
+        const altText = altTexts[path];
+        if (altText === undefined) return;
+        onMediaChange(media.map((m) => (m.path === path ? { ...m, altText } : m)));
+    };
+
     return (
         <div className="p-4 bg-secondary/50 rounded-lg">
             {/* Original Tweet */}
             <div className="mb-4">
-                <p className="text-xs text-muted-foreground mb-1">Original Tweet</p>
+                <p className="text-xs text-muted-foreground mb-1">{isTweet ? 'Alert' : 'Original Tweet'}</p>
                 <div className="p-3 bg-background rounded-lg border border-border">
                     <div className="flex items-center gap-2 mb-1">
                         <span className="font-semibold text-sm">
                             {item.originalTweet.authorName || item.originalTweet.authorUsername}
                         </span>
                         <span className="text-muted-foreground text-sm">@{item.originalTweet.authorUsername}</span>
+                        {item.originalTweet.allowlisted && (
+                            <Badge variant="outline">Allowlisted</Badge>
+                        )}
+                        {!isTweet && item.originalTweet.authorScore !== undefined && item.originalTweet.authorScore > 0 && (
+                            <span className="text-xs text-muted-foreground" title="Author quality score">
+                                Score {item.originalTweet.authorScore}
+                            </span>
+                        )}
+                        {item.originalTweet.relevance !== undefined && (
+                            <span className="text-xs text-muted-foreground" title={item.originalTweet.relevanceReason}>
+                                Relevance {Math.round(item.originalTweet.relevance * 100)}%
+                                {item.originalTweet.sentiment && ` · ${item.originalTweet.sentiment}`}
+                            </span>
+                        )}
                     </div>
-                    <p>{item.originalTweet.text}</p>
+                    <p className="whitespace-pre-line">{item.originalTweet.text}</p>
                 </div>
             </div>
 
             {/* Generated Reply */}
             <div className="mb-4">
-                <p className="text-xs text-muted-foreground mb-1">Generated Reply</p>
-                <div className="p-3 bg-blue-900/20 border border-blue-800/50 rounded-lg">
-                    <p>{item.reply.text}</p>
+                <p className="text-xs text-muted-foreground mb-1">
+                    {isTweet ? 'Generated Tweet' : 'Generated Reply'}
+                    {item.reply.variant && <span className="ml-2">· variant {item.reply.variant}</span>}
+                    {!!item.reply.followUpDepth && <span className="ml-2">· follow-up #{item.reply.followUpDepth}</span>}
+                </p>
+                {candidates.length > 1 && (
+                    <div className="space-y-2 mb-2">
+                        {candidates.map((c, i) => (
+                            <button
+                                key={i}
+                                className={`w-full text-left p-2 rounded-lg border text-sm transition-colors ${
+                                    i === item.reply.chosenCandidate
+                                        ? 'border-primary bg-primary/10'
+                                        : 'border-border bg-background hover:border-primary/50'
+                                }`}
+                                onClick={() => onSelectCandidate(i)}
+                                title={c.style || 'Account settings only'}
+                            >
+                                <span className="text-xs text-muted-foreground mr-2">#{i + 1}</span>
+                                {c.text}
+                            </button>
+                        ))}
+                    </div>
+                )}
+                <Textarea
+                    className="bg-blue-900/20 border-blue-800/50"
+                    value={draft}
+                    onChange={(e) => setDraft(e.target.value)}
+                    onBlur={handleDraftSave}
+                />
+                <p className="text-xs text-muted-foreground mt-1">{draft.length} characters</p>
+                {item.reply.violations && item.reply.violations.length > 0 && (
+                    <div className="mt-2 p-2 rounded-lg border border-yellow-800/50 bg-yellow-900/20 text-xs text-yellow-400 space-y-1">
+                        {item.reply.violations.map((v) => (
+                            <p key={v} className="flex items-center gap-1">
+                                <AlertTriangle size={12} />
+                                {v}
+                            </p>
+                        ))}
+                    </div>
+                )}
+            </div>
+
+            {/* Attachments */}
+            <div className="mb-4">
+                <div className="flex items-center justify-between mb-1">
+                    <p className="text-xs text-muted-foreground">Attachments ({media.length}/4)</p>
+                    <Button variant="ghost" size="sm" onClick={handleAttach} disabled={media.length >= 4}>
+                        <Paperclip size={14} />
+                        Attach
+                    </Button>
                 </div>
+                {media.length > 0 && (
+                    <div className="space-y-2">
+                        {media.map((m) => (
+                            <div key={m.path} className="flex items-center gap-2 p-2 bg-background rounded-lg border border-border">
+                                <ImageIcon size={14} className="text-muted-foreground shrink-0" />
+                                <span className="text-sm truncate max-w-[200px]" title={m.path}>
+                                    {m.path.split(/[\\/]/).pop()}
+                                </span>
+                                <input
+                                    className="flex-1 min-w-0 bg-transparent text-sm border-b border-border focus:outline-none focus:border-primary"
+                                    placeholder="Alt text (optional)"
+                                    value={altTexts[m.path] ?? m.altText ?? ''}
+                                    onChange={(e) => setAltTexts({ ...altTexts, [m.path]: e.target.value })}
+                                    onBlur={() => handleAltTextSave(m.path)}
+                                />
+                                <button
+                                    className="text-muted-foreground hover:text-red-400"
+                                    onClick={() => handleRemove(m.path)}
+                                    title="Remove attachment"
+                                >
+                                    <X size={14} />
+                                </button>
+                            </div>
+                        ))}
+                    </div>
+                )}
             </div>
 
             {/* Actions */}
@@ -513,7 +806,7 @@ function ApprovalCard({ item, onApprove, onReject }: {
 }
 
 // Reply History Card Component
-function ReplyHistoryCard({ reply }: { reply: Reply }) {
+function ReplyHistoryCard({ reply, onPostNow, onDelete }: { reply: Reply; onPostNow: () => void; onDelete: () => void }) {
     const getStatusIcon = (status: string) => {
         switch (status) {
             case 'posted':
@@ -522,8 +815,11 @@ function ReplyHistoryCard({ reply }: { reply: Reply }) {
                 return <XCircle size={16} className="text-red-400" />;
             case 'failed':
                 return <AlertCircle size={16} className="text-red-400" />;
+            case 'deleted':
+                return <Trash2 size={16} className="text-muted-foreground" />;
             case 'pending':
             case 'approved':
+            case 'scheduled':
                 return <Clock size={16} className="text-yellow-400" />;
             default:
                 return <Clock size={16} className="text-muted-foreground" />;
@@ -541,6 +837,7 @@ function ReplyHistoryCard({ reply }: { reply: Reply }) {
             case 'pending':
                 return 'bg-yellow-600/20 text-yellow-400';
             case 'approved':
+            case 'scheduled':
                 return 'bg-blue-600/20 text-blue-400';
             default:
                 return 'bg-muted text-muted-foreground';
@@ -565,19 +862,48 @@ function ReplyHistoryCard({ reply }: { reply: Reply }) {
                                 : new Date(reply.generatedAt).toLocaleString()}
                         </span>
                     </div>
+                    {reply.status === 'scheduled' && reply.scheduledFor && (
+                        <div className="flex items-center gap-2 mb-2 text-xs text-muted-foreground">
+                            Posting at {new Date(reply.scheduledFor).toLocaleString()}
+                            <button className="text-primary hover:underline" onClick={onPostNow}>
+                                Post now
+                            </button>
+                        </div>
+                    )}
+                    {reply.originalTweet && (
+                        <div className="mb-2 pl-3 border-l-2 border-border">
+                            <p className="text-xs text-muted-foreground">
+                                @{reply.originalTweet.authorUsername}
+                                {reply.originalTweet.authorFollowers ? ` · ${reply.originalTweet.authorFollowers.toLocaleString()} followers` : ''}
+                                {` · ${reply.originalTweet.likeCount.toLocaleString()} likes · ${reply.originalTweet.viewCount.toLocaleString()} views`}
+                            </p>
+                            <p className="text-xs text-muted-foreground line-clamp-3">{reply.originalTweet.text}</p>
+                        </div>
+                    )}
                     <p className="text-sm">{reply.text}</p>
+                    {reply.media && reply.media.length > 0 && (
+                        <p className="text-xs text-muted-foreground mt-1 flex items-center gap-1">
+                            <ImageIcon size={12} />
+                            {reply.media.length} attachment{reply.media.length > 1 ? 's' : ''}
+                        </p>
+                    )}
                     {reply.errorMessage && (
                         <p className="text-red-400 text-xs mt-1">{reply.errorMessage}</p>
                     )}
-                    {reply.postedReplyId && (
-                        <a
-                            href={`https://x.com/i/web/status/${reply.postedReplyId}`}
-                            target="_blank"
-                            rel="noopener noreferrer"
-                            className="text-primary text-xs mt-1 hover:underline"
-                        >
-                            View on X
-                        </a>
+                    {reply.postedReplyId && reply.status === 'posted' && (
+                        <div className="flex items-center gap-3 mt-1 text-xs">
+                            <a
+                                href={`https://x.com/i/web/status/${reply.postedReplyId}`}
+                                target="_blank"
+                                rel="noopener noreferrer"
+                                className="text-primary hover:underline"
+                            >
+                                View on X
+                            </a>
+                            <button className="text-destructive hover:underline" onClick={onDelete}>
+                                Delete
+                            </button>
+                        </div>
                     )}
                 </div>
             </div>
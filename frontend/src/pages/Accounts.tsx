@@ -44,6 +44,7 @@ const createDefaultAccount = (): AccountConfig => ({
         minRetweets: 10,
         maxAgeMins: 60,
         intervalSecs: 300,
+        maxPages: 1,
     },
     replyConfig: {
         approvalMode: 'queue',
@@ -58,6 +59,11 @@ const createDefaultAccount = (): AccountConfig => ({
         repliesPerDay: 50,
         minDelayBetween: 60,
     },
+    alertBridge: {
+        enabled: false,
+        minTradeValue: 10000,
+        dailyCap: 5,
+    },
 });
 
 interface AccountStats {
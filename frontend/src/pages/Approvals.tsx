@@ -0,0 +1,233 @@
+import { useEffect, useState } from 'react';
+import { AlertTriangle, Check, RefreshCw, X } from 'lucide-react';
+import Card from '../components/common/Card';
+import Button from '../components/common/Button';
+import { Badge } from '../components/ui/badge';
+import { Checkbox } from '../components/ui/checkbox';
+import { Input } from '../components/ui/input';
+import {
+    Select,
+    SelectContent,
+    SelectItem,
+    SelectTrigger,
+    SelectValue,
+} from '../components/ui/select';
+import { useAccountStore } from '../store/accountStore';
+import { useUIStore } from '../store/uiStore';
+import { ApprovalQueueItem, BulkResult, PendingFilter, PendingSort } from '../types';
+import {
+    ApproveReplies,
+    GetAccounts,
+    GetAllPendingReplies,
+    RejectReplies,
+} from '../../wailsjs/go/main/App';
+
+const ALL_ACCOUNTS = 'all';
+
+export default function Approvals() {
+    const { accounts, setAccounts } = useAccountStore();
+    const { showToast } = useUIStore();
+    const [items, setItems] = useState<ApprovalQueueItem[]>([]);
+    const [selected, setSelected] = useState<Set<string>>(new Set());
+    const [accountId, setAccountId] = useState(ALL_ACCOUNTS);
+    const [sortBy, setSortBy] = useState<PendingSort>('priority');
+    const [search, setSearch] = useState('');
+    const [isLoading, setIsLoading] = useState(false);
+    const [isWorking, setIsWorking] = useState(false);
+
+    useEffect(() => {
+        GetAccounts().then((accs) => setAccounts(accs || [])).catch(() => {});
+    }, []);
+
+    useEffect(() => {
+        const timer = setTimeout(loadItems, 300);
+        return () => clearTimeout(timer);
+    }, [accountId, sortBy, search]);
+
+    const loadItems = async () => {
+        setIsLoading(true);
+        try {
+            const filter: PendingFilter = {
+                accountIds: accountId === ALL_ACCOUNTS ? undefined : [accountId],
+                search: search || undefined,
+                sortBy,
+            };
+            const result = await GetAllPendingReplies(filter as any);
+            const loaded = (result || []) as ApprovalQueueItem[];
+            setItems(loaded);
+            // Drop selections that left the queue
+            setSelected((prev) => new Set(loaded.filter((i) => prev.has(i.reply.id)).map((i) => i.reply.id)));
+        } catch (err: any) {
+            showToast(err?.message || 'Failed to load approval queue', 'error');
+        } finally {
+            setIsLoading(false);
+        }
+    };
+
+    const toggle = (replyId: string) => {
+        setSelected((prev) => {
+            const next = new Set(prev);
+            if (next.has(replyId)) {
+                next.delete(replyId);
+            } else {
+                next.add(replyId);
+            }
+            return next;
+        });
+    };
+
+    const allSelected = items.length > 0 && selected.size === items.length;
+    const toggleAll = () => {
+        setSelected(allSelected ? new Set() : new Set(items.map((i) => i.reply.id)));
+    };
+
+    const runBulk = async (action: (ids: string[]) => Promise<any>, verb: string) => {
+        setIsWorking(true);
+        try {
+            const result: BulkResult = await action(Array.from(selected));
+            const failed = Object.keys(result.failed || {}).length;
+            const succeeded = result.succeeded?.length || 0;
+            if (failed > 0) {
+                const firstError = Object.values(result.failed)[0];
+                showToast(`${verb} ${succeeded}, ${failed} failed: ${firstError}`, 'error');
+            } else {
+                showToast(`${verb} ${succeeded} repl${succeeded === 1 ? 'y' : 'ies'}`, 'success');
+            }
+        } catch (err: any) {
+            showToast(err?.message || 'Failed to update replies', 'error');
+        } finally {
+            setIsWorking(false);
+            loadItems();
+        }
+    };
+
+    const usernameOf = (id: string) => accounts.find((a) => a.id === id)?.username || id;
+
+    return (
+        <div className="space-y-6">
+            <div className="flex items-center justify-between">
+                <h1 className="text-2xl font-bold">Approvals</h1>
+                <Button variant="ghost" size="sm" onClick={loadItems} disabled={isLoading}>
+                    <RefreshCw size={16} className={isLoading ? 'animate-spin' : ''} />
+                    Refresh
+                </Button>
+            </div>
+
+            {/* Filters */}
+            <Card>
+                <div className="flex flex-wrap items-center gap-4">
+                    <Select value={accountId} onValueChange={setAccountId}>
+                        <SelectTrigger className="w-[200px]">
+                            <SelectValue />
+                        </SelectTrigger>
+                        <SelectContent>
+                            <SelectItem value={ALL_ACCOUNTS}>All accounts</SelectItem>
+                            {accounts.map((acc) => (
+                                <SelectItem key={acc.id} value={acc.id}>
+                                    @{acc.username}
+                                </SelectItem>
+                            ))}
+                        </SelectContent>
+                    </Select>
+                    <Select value={sortBy} onValueChange={(v) => setSortBy(v as PendingSort)}>
+                        <SelectTrigger className="w-[180px]">
+                            <SelectValue />
+                        </SelectTrigger>
+                        <SelectContent>
+                            <SelectItem value="priority">Highest priority</SelectItem>
+                            <SelectItem value="expires">Expiring soonest</SelectItem>
+                            <SelectItem value="views">Most views</SelectItem>
+                            <SelectItem value="relevance">Most relevant</SelectItem>
+                            <SelectItem value="queued">Oldest first</SelectItem>
+                        </SelectContent>
+                    </Select>
+                    <Input
+                        className="w-[240px]"
+                        value={search}
+                        onChange={(e) => setSearch(e.target.value)}
+                        placeholder="Search text or author"
+                    />
+                </div>
+            </Card>
+
+            <Card
+                title={`Pending Approvals (${items.length})`}
+                actions={
+                    <>
+                        <Button
+                            variant="primary"
+                            size="sm"
+                            onClick={() => runBulk(ApproveReplies, 'Approved')}
+                            disabled={selected.size === 0 || isWorking}
+                        >
+                            <Check size={14} />
+                            Approve ({selected.size})
+                        </Button>
+                        <Button
+                            variant="danger"
+                            size="sm"
+                            onClick={() => runBulk(RejectReplies, 'Rejected')}
+                            disabled={selected.size === 0 || isWorking}
+                        >
+                            <X size={14} />
+                            Reject ({selected.size})
+                        </Button>
+                    </>
+                }
+            >
+                {items.length === 0 ? (
+                    <p className="text-muted-foreground text-center py-8">
+                        No pending replies. Replies awaiting approval will appear here.
+                    </p>
+                ) : (
+                    <div className="space-y-2">
+                        <label className="flex items-center gap-2 text-sm text-muted-foreground">
+                            <Checkbox checked={allSelected} onCheckedChange={toggleAll} />
+                            Select all
+                        </label>
+                        {items.map((item) => (
+                            <div key={item.reply.id} className="flex gap-3 p-3 bg-secondary/50 rounded-lg">
+                                <Checkbox
+                                    className="mt-1"
+                                    checked={selected.has(item.reply.id)}
+                                    onCheckedChange={() => toggle(item.reply.id)}
+                                />
+                                <div className="flex-1 min-w-0 space-y-2">
+                                    <div className="flex flex-wrap items-center gap-2 text-xs text-muted-foreground">
+                                        <Badge variant="secondary">@{usernameOf(item.reply.accountId)}</Badge>
+                                        <span title="Priority from views, author reach and tweet age">
+                                            Priority {Math.round(item.priority)}
+                                        </span>
+                                        {item.originalTweet.relevance !== undefined && (
+                                            <span title={item.originalTweet.relevanceReason}>
+                                                Relevance {Math.round(item.originalTweet.relevance * 100)}%
+                                            </span>
+                                        )}
+                                        <span>{item.originalTweet.viewCount.toLocaleString()} views</span>
+                                        <span>Expires {new Date(item.expiresAt).toLocaleString()}</span>
+                                        {item.reply.violations && item.reply.violations.length > 0 && (
+                                            <span
+                                                className="flex items-center gap-1 text-yellow-400"
+                                                title={item.reply.violations.join('\n')}
+                                            >
+                                                <AlertTriangle size={12} />
+                                                {item.reply.violations.length} guardrail violation{item.reply.violations.length === 1 ? '' : 's'}
+                                            </span>
+                                        )}
+                                    </div>
+                                    <p className="text-sm text-muted-foreground whitespace-pre-line">
+                                        <span className="font-medium text-foreground">@{item.originalTweet.authorUsername}</span>{' '}
+                                        {item.originalTweet.text}
+                                    </p>
+                                    <p className="text-sm p-2 bg-blue-900/20 border border-blue-800/50 rounded-lg">
+                                        {item.reply.text}
+                                    </p>
+                                </div>
+                            </div>
+                        ))}
+                    </div>
+                )}
+            </Card>
+        </div>
+    );
+}
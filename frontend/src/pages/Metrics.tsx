@@ -1,6 +1,6 @@
 import { useEffect, useState } from 'react';
 import { useNavigate } from 'react-router-dom';
-import { BarChart3, TrendingUp, MessageSquare, Clock, RefreshCw, ChevronRight } from 'lucide-react';
+import { BarChart3, TrendingUp, MessageSquare, Clock, RefreshCw, ChevronRight, ExternalLink } from 'lucide-react';
 import Card from '../components/common/Card';
 import Button from '../components/common/Button';
 import { Badge } from '../components/ui/badge';
@@ -21,10 +21,11 @@ import {
 } from '../components/ui/table';
 import { useAccountStore } from '../store/accountStore';
 import { useUIStore } from '../store/uiStore';
-import { DailyStats, ReplyPerformanceReport } from '../types';
+import { DailyStats, ExperimentResults, ReplyPerformanceReport } from '../types';
 import {
     GetAccounts,
     GetDailyStats,
+    GetExperimentResults,
     GetReplyPerformance,
 } from '../../wailsjs/go/main/App';
 
@@ -35,6 +36,7 @@ export default function Metrics() {
     const [selectedAccountId, setSelectedAccountId] = useState<string>('');
     const [dailyStats, setDailyStats] = useState<DailyStats[]>([]);
     const [replyPerformance, setReplyPerformance] = useState<ReplyPerformanceReport | null>(null);
+    const [experiment, setExperiment] = useState<ExperimentResults | null>(null);
     const [days, setDays] = useState(7);
     const [autoRefresh, setAutoRefresh] = useState(false);
     const [isRefreshing, setIsRefreshing] = useState(false);
@@ -49,6 +51,7 @@ export default function Metrics() {
         } else {
             setDailyStats([]);
             setReplyPerformance(null);
+            setExperiment(null);
         }
     }, [selectedAccountId, days]);
 
@@ -78,12 +81,14 @@ export default function Metrics() {
     const loadMetrics = async (accountId: string, silent = false) => {
         if (!silent) setIsRefreshing(true);
         try {
-            const [stats, performance] = await Promise.all([
+            const [stats, performance, results] = await Promise.all([
                 GetDailyStats(accountId, days),
                 GetReplyPerformance(accountId, days),
+                GetExperimentResults(accountId),
             ]);
             setDailyStats(stats || []);
             setReplyPerformance(performance || null);
+            setExperiment(results || null);
         } catch (err) {
             if (!silent) showToast('Failed to load metrics', 'error');
         } finally {
@@ -276,6 +281,85 @@ export default function Metrics() {
                                     <p className="text-xs text-muted-foreground">Avg Impressions</p>
                                 </div>
                             </div>
+                            {replyPerformance.topPerformingReplies?.length > 0 && (
+                                <div className="mt-4 space-y-2">
+                                    <p className="text-sm font-medium">Top Performing Replies</p>
+                                    {replyPerformance.topPerformingReplies.map((m) => (
+                                        <div key={m.replyId} className="flex items-start gap-3 p-3 bg-secondary/50 rounded-lg">
+                                            <p className="flex-1 text-sm whitespace-pre-line">{m.text || m.replyId}</p>
+                                            <div className="flex items-center gap-3 text-xs text-muted-foreground shrink-0">
+                                                <span>{m.likeCount.toLocaleString()} likes</span>
+                                                <span>{m.retweetCount.toLocaleString()} retweets</span>
+                                                <span>{m.impressions.toLocaleString()} views</span>
+                                                {m.postedReplyId && (
+                                                    <a
+                                                        href={`https://x.com/i/web/status/${m.postedReplyId}`}
+                                                        target="_blank"
+                                                        rel="noopener noreferrer"
+                                                        className="text-primary hover:underline"
+                                                        title="View on X"
+                                                    >
+                                                        <ExternalLink size={12} />
+                                                    </a>
+                                                )}
+                                            </div>
+                                        </div>
+                                    ))}
+                                </div>
+                            )}
+                        </Card>
+                    )}
+
+                    {/* Experiment Results */}
+                    {experiment && (experiment.variants?.length ?? 0) > 0 && (
+                        <Card
+                            title="Experiment Results"
+                            actions={!experiment.enabled && <Badge variant="secondary">Stopped</Badge>}
+                        >
+                            <div className="overflow-x-auto">
+                                <Table>
+                                    <TableHeader>
+                                        <TableRow>
+                                            <TableHead>Variant</TableHead>
+                                            <TableHead className="text-right">Weight</TableHead>
+                                            <TableHead className="text-right">Generated</TableHead>
+                                            <TableHead className="text-right">Posted</TableHead>
+                                            <TableHead className="text-right">Measured ({experiment.checkpointHours}h)</TableHead>
+                                            <TableHead className="text-right">Avg Likes</TableHead>
+                                            <TableHead className="text-right">Avg Views</TableHead>
+                                            <TableHead className="text-right">Lift</TableHead>
+                                            <TableHead>Significance</TableHead>
+                                        </TableRow>
+                                    </TableHeader>
+                                    <TableBody>
+                                        {experiment.variants.map((v) => (
+                                            <TableRow key={v.name}>
+                                                <TableCell className="font-medium">{v.name}</TableCell>
+                                                <TableCell className="text-right">{v.weight || '-'}</TableCell>
+                                                <TableCell className="text-right">{v.generated}</TableCell>
+                                                <TableCell className="text-right">{v.posted}</TableCell>
+                                                <TableCell className="text-right">{v.measured}</TableCell>
+                                                <TableCell className="text-right">{v.avgLikes.toFixed(1)}</TableCell>
+                                                <TableCell className="text-right">{Math.round(v.avgImpressions).toLocaleString()}</TableCell>
+                                                <TableCell className="text-right">
+                                                    {v.significance === 'baseline'
+                                                        ? '-'
+                                                        : `${v.likeLift >= 0 ? '+' : ''}${(v.likeLift * 100).toFixed(0)}%`}
+                                                </TableCell>
+                                                <TableCell>
+                                                    {v.significance === 'significant' ? (
+                                                        <Badge variant="outline" className="bg-green-500/10 text-green-500 border-green-500/20">
+                                                            p = {v.pValue.toFixed(3)}
+                                                        </Badge>
+                                                    ) : (
+                                                        <Badge variant="secondary">{v.significance}</Badge>
+                                                    )}
+                                                </TableCell>
+                                            </TableRow>
+                                        ))}
+                                    </TableBody>
+                                </Table>
+                            </div>
                         </Card>
                     )}
 
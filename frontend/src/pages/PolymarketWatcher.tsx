@@ -746,7 +746,7 @@ export default function PolymarketWatcher() {
                     </DialogHeader>
 
                     <div className="space-y-4 py-4">
-                        {/* Telegram Notifications Toggle */}
+                        {/* Notifications Toggle */}
                         <div className="p-3 rounded-lg bg-primary/10 border border-primary/30">
                             <div className="flex items-center justify-between">
                                 <div className="flex items-center gap-2">
@@ -755,7 +755,7 @@ export default function PolymarketWatcher() {
                                     ) : (
                                         <BellOff size={16} className="text-muted-foreground" />
                                     )}
-                                    <span className="font-medium">Telegram Notifications</span>
+                                    <span className="font-medium">Notifications</span>
                                 </div>
                                 <label className="relative inline-flex items-center cursor-pointer">
                                     <input
@@ -763,15 +763,15 @@ export default function PolymarketWatcher() {
                                         checked={notifyBigTrades}
                                         onChange={(e) => setNotifyBigTrades(e.target.checked)}
                                         className="sr-only peer"
-                                        disabled={!notificationConfig?.enabled || !notificationConfig?.telegramBotToken}
+                                        disabled={!notificationConfig?.enabled}
                                     />
                                     <div className="w-11 h-6 bg-gray-200 peer-focus:outline-none peer-focus:ring-2 peer-focus:ring-primary/50 rounded-full peer dark:bg-gray-700 peer-checked:after:translate-x-full peer-checked:after:border-white after:content-[''] after:absolute after:top-[2px] after:left-[2px] after:bg-white after:border-gray-300 after:border after:rounded-full after:h-5 after:w-5 after:transition-all dark:border-gray-600 peer-checked:bg-primary peer-disabled:opacity-50"></div>
                                 </label>
                             </div>
                             <p className="text-xs text-muted-foreground mt-2">
-                                {notificationConfig?.enabled && notificationConfig?.telegramBotToken
+                                {notificationConfig?.enabled
                                     ? 'Receive notifications for big trades matching your filter settings.'
-                                    : 'Configure Telegram in Settings page first to enable notifications.'}
+                                    : 'Enable notifications in Settings page first.'}
                             </p>
                         </div>
 
@@ -142,6 +142,11 @@ function TweetCard({ tweet }: { tweet: Tweet }) {
                     <div className="flex items-center gap-2 mb-1">
                         <span className="font-semibold">{tweet.authorName || tweet.authorUsername}</span>
                         <span className="text-gray-400">@{tweet.authorUsername}</span>
+                        {tweet.authorScore !== undefined && tweet.authorScore > 0 && (
+                            <span className="text-xs text-gray-400" title="Author quality score">
+                                Score {tweet.authorScore}
+                            </span>
+                        )}
                     </div>
                     <p className="text-gray-100 mb-3">{tweet.text}</p>
 
@@ -1,14 +1,33 @@
 import { useEffect, useState } from 'react';
-import { FolderOpen, RefreshCw, FileText, Download, ExternalLink, Check, Trash2, AlertTriangle, Database, ScrollText, Bell, Send, Eye, EyeOff } from 'lucide-react';
+import { FolderOpen, RefreshCw, FileText, Download, ExternalLink, Check, Trash2, AlertTriangle, Database, ScrollText, Bell, Send, Eye, EyeOff, RotateCcw } from 'lucide-react';
 import Card from '../components/common/Card';
 import Button from '../components/common/Button';
 import { Badge } from '../components/ui/badge';
 import { Input } from '../components/ui/input';
+import { Textarea } from '../components/ui/textarea';
 import ConfirmModal from '../components/common/ConfirmModal';
 import LogsViewerModal from '../components/common/LogsViewerModal';
 import { useUIStore } from '../store/uiStore';
-import { UpdateInfo, DatabaseInfo, NotificationConfig } from '../types';
-import { CheckForUpdates, GetAppVersion, GetDataDir, GetDatabaseInfo, ClearPolymarketEvents, OpenFolder, GetNotificationConfig, SetNotificationConfig, SendTestNotification, GetBrowserPath } from '../../wailsjs/go/main/App';
+import { UpdateInfo, DatabaseInfo, NotificationConfig, NotificationChannel, NotificationEventType, NotificationTemplate, SMTPConfig, SMTPSecurity } from '../types';
+import { CheckForUpdates, GetAppVersion, GetDataDir, GetDatabaseInfo, ClearPolymarketEvents, OpenFolder, GetNotificationConfig, SetNotificationConfig, SendTestNotification, SendNotificationDigest, GetBrowserPath, PreviewNotificationTemplate, GetDefaultNotificationTemplates } from '../../wailsjs/go/main/App';
+
+// Event types that can be routed and templated
+const NOTIFICATION_EVENTS: [NotificationEventType, string][] = [
+    ['big_trade', 'Big trades'],
+    ['fresh_wallet', 'Fresh wallets'],
+    ['approval_required', 'Approval queue'],
+    ['reply_failed', 'Reply failures'],
+    ['account_error', 'Account errors'],
+];
+
+const NOTIFICATION_CHANNELS: [NotificationChannel, string][] = [
+    ['telegram', 'Telegram'],
+    ['desktop', 'Desktop'],
+    ['email', 'Email'],
+    ['discord', 'Discord'],
+    ['slack', 'Slack'],
+    ['webhook', 'Webhook'],
+];
 
 export default function Settings() {
     const { showToast } = useUIStore();
@@ -29,7 +48,29 @@ export default function Settings() {
     const [isSavingNotification, setIsSavingNotification] = useState(false);
     const [isSendingTest, setIsSendingTest] = useState(false);
     const [showBotToken, setShowBotToken] = useState(false);
+    const [routes, setRoutes] = useState<Partial<Record<NotificationEventType, NotificationChannel[]>>>({});
+    const [smtp, setSmtp] = useState<SMTPConfig>({ host: '', port: 587, security: 'starttls', username: '', password: '', from: '', recipients: [] });
+    const [emailRecipients, setEmailRecipients] = useState(''); // Comma-separated string
+    const [digestEnabled, setDigestEnabled] = useState(false);
+    const [digestHour, setDigestHour] = useState(8);
+    const [isSendingDigest, setIsSendingDigest] = useState(false);
+    const [notifyApprovalQueue, setNotifyApprovalQueue] = useState(false);
+    const [notifyReplyFailures, setNotifyReplyFailures] = useState(false);
+    const [notifyAccountErrors, setNotifyAccountErrors] = useState(false);
+    const [replyFailureThreshold, setReplyFailureThreshold] = useState(3);
     const [browserPath, setBrowserPath] = useState<string>('');
+    const [discordWebhookURL, setDiscordWebhookURL] = useState('');
+    const [slackWebhookURL, setSlackWebhookURL] = useState('');
+    const [webhookURL, setWebhookURL] = useState('');
+
+    // Message templates; only templates that differ from the defaults are saved
+    const [templates, setTemplates] = useState<NotificationTemplate[]>([]);
+    const [defaultTemplates, setDefaultTemplates] = useState<NotificationTemplate[]>([]);
+    const [templateEvent, setTemplateEvent] = useState<NotificationEventType>('big_trade');
+    const [templateChannel, setTemplateChannel] = useState<NotificationChannel>('telegram');
+    const [templatePreview, setTemplatePreview] = useState<string | null>(null);
+    const [templateError, setTemplateError] = useState<string | null>(null);
+    const [isPreviewing, setIsPreviewing] = useState(false);
 
     const DB_SIZE_WARNING_THRESHOLD = 20 * 1024 * 1024; // 20MB
 
@@ -59,27 +100,108 @@ export default function Settings() {
             // Join array into comma-separated string for display
             setTelegramChatIDs((config.telegramChatIDs || []).join(', '));
             setNotificationsEnabled(config.enabled || false);
+            setRoutes(config.routes || {});
+            if (config.smtp) {
+                setSmtp(config.smtp);
+                setEmailRecipients((config.smtp.recipients || []).join(', '));
+            }
+            setNotifyApprovalQueue(config.notifyApprovalQueue || false);
+            setNotifyReplyFailures(config.notifyReplyFailures || false);
+            setNotifyAccountErrors(config.notifyAccountErrors || false);
+            setReplyFailureThreshold(config.replyFailureThreshold || 3);
+            setDigestEnabled(config.digestEnabled || false);
+            setDigestHour(config.digestHour ?? 8);
+            setDiscordWebhookURL(config.discordWebhookURL || '');
+            setSlackWebhookURL(config.slackWebhookURL || '');
+            setWebhookURL(config.webhookURL || '');
+            setTemplates((config.templates || []) as NotificationTemplate[]);
+            setDefaultTemplates((await GetDefaultNotificationTemplates()) as NotificationTemplate[]);
         } catch (err) {
             console.error('Failed to load notification config:', err);
         }
     };
 
+    const isTemplateFor = (t: NotificationTemplate) => t.eventType === templateEvent && t.channel === templateChannel;
+    const defaultTemplateBody = defaultTemplates.find(isTemplateFor)?.body ?? '';
+    const customTemplate = templates.find(isTemplateFor);
+    const templateBody = customTemplate?.body ?? defaultTemplateBody;
+
+    const selectTemplate = (eventType: NotificationEventType, channel: NotificationChannel) => {
+        setTemplateEvent(eventType);
+        setTemplateChannel(channel);
+        setTemplatePreview(null);
+        setTemplateError(null);
+    };
+
+    const setTemplateBody = (body: string) => {
+        const others = templates.filter(t => !isTemplateFor(t));
+        setTemplates(body === defaultTemplateBody
+            ? others
+            : [...others, { eventType: templateEvent, channel: templateChannel, body }]);
+        setTemplatePreview(null);
+        setTemplateError(null);
+    };
+
+    const handlePreviewTemplate = async () => {
+        setIsPreviewing(true);
+        try {
+            const preview = await PreviewNotificationTemplate({ eventType: templateEvent, channel: templateChannel, body: templateBody });
+            setTemplatePreview(preview);
+            setTemplateError(null);
+        } catch (err: any) {
+            setTemplatePreview(null);
+            setTemplateError(typeof err === 'string' ? err : (err?.message || 'Failed to render template'));
+        } finally {
+            setIsPreviewing(false);
+        }
+    };
+
     // Parse comma-separated chat IDs into array
     const parseChatIDs = (input: string): string[] => {
         return input.split(',').map(id => id.trim()).filter(id => id.length > 0);
     };
 
+    // Channels an event type is delivered to (defaults to Telegram only)
+    const channelsFor = (eventType: NotificationEventType): NotificationChannel[] => {
+        return routes[eventType] ?? [notificationConfig?.channel || 'telegram'];
+    };
+
+    const toggleRoute = (eventType: NotificationEventType, channel: NotificationChannel) => {
+        const current = channelsFor(eventType);
+        const next = current.includes(channel)
+            ? current.filter(c => c !== channel)
+            : [...current, channel];
+        setRoutes({ ...routes, [eventType]: next });
+    };
+
+    const usesTelegram = (['big_trade', 'fresh_wallet', 'approval_required', 'reply_failed', 'account_error'] as NotificationEventType[])
+        .some(eventType => channelsFor(eventType).includes('telegram'));
+    const telegramReady = !!telegramBotToken && !!telegramChatIDs.trim();
+    const emailReady = !!smtp.host && smtp.port > 0 && !!smtp.from && !!emailRecipients.trim();
+    const webhookURLs = { discordWebhookURL, slackWebhookURL, webhookURL };
+
     const handleSaveNotificationConfig = async () => {
         setIsSavingNotification(true);
         try {
             const chatIDsArray = parseChatIDs(telegramChatIDs);
             const newConfig: NotificationConfig = {
+                ...notificationConfig,
+                routes,
                 enabled: notificationsEnabled,
                 channel: 'telegram',
                 telegramBotToken,
                 telegramChatIDs: chatIDsArray,
+                smtp: { ...smtp, recipients: parseChatIDs(emailRecipients) },
+                ...webhookURLs,
+                templates: templates.filter(t => t.body.trim()), // Empty falls back to the default
                 notifyBigTrades: notificationConfig?.notifyBigTrades ?? false,
                 notifyFreshWallets: notificationConfig?.notifyFreshWallets ?? false,
+                notifyApprovalQueue,
+                notifyReplyFailures,
+                notifyAccountErrors,
+                replyFailureThreshold,
+                digestEnabled,
+                digestHour,
             };
             await SetNotificationConfig(newConfig);
             setNotificationConfig(newConfig);
@@ -97,14 +219,20 @@ export default function Settings() {
         const currentChatIDsStr = (notificationConfig?.telegramChatIDs || []).join(', ');
         if (telegramBotToken !== notificationConfig?.telegramBotToken ||
             telegramChatIDs !== currentChatIDsStr ||
-            notificationsEnabled !== notificationConfig?.enabled) {
+            notificationsEnabled !== notificationConfig?.enabled ||
+            JSON.stringify(routes) !== JSON.stringify(notificationConfig?.routes || {}) ||
+            JSON.stringify(templates) !== JSON.stringify(notificationConfig?.templates || []) ||
+            discordWebhookURL !== (notificationConfig?.discordWebhookURL || '') ||
+            slackWebhookURL !== (notificationConfig?.slackWebhookURL || '') ||
+            webhookURL !== (notificationConfig?.webhookURL || '') ||
+            JSON.stringify({ ...smtp, recipients: parseChatIDs(emailRecipients) }) !== JSON.stringify(notificationConfig?.smtp)) {
             await handleSaveNotificationConfig();
         }
 
         setIsSendingTest(true);
         try {
             await SendTestNotification();
-            showToast('Test notification sent! Check your Telegram.', 'success');
+            showToast('Test notification sent to all configured channels.', 'success');
         } catch (err: any) {
             const errorMsg = typeof err === 'string' ? err : (err?.message || 'Failed to send test notification');
             showToast(errorMsg, 'error');
@@ -113,6 +241,21 @@ export default function Settings() {
         }
     };
 
+    const handleSendDigest = async () => {
+        await handleSaveNotificationConfig();
+
+        setIsSendingDigest(true);
+        try {
+            await SendNotificationDigest();
+            showToast('Digest email sent', 'success');
+        } catch (err: any) {
+            const errorMsg = typeof err === 'string' ? err : (err?.message || 'Failed to send digest');
+            showToast(errorMsg, 'error');
+        } finally {
+            setIsSendingDigest(false);
+        }
+    };
+
     const loadVersion = async () => {
         try {
             const v = await GetAppVersion();
@@ -183,8 +326,8 @@ export default function Settings() {
         <div className="space-y-6">
             <h1 className="text-2xl font-bold">Settings</h1>
 
-            {/* Telegram Notifications Card */}
-            <Card title="Telegram Notifications">
+            {/* Notifications Card */}
+            <Card title="Notifications">
                 <div className="space-y-4">
                     <div className="flex items-center justify-between">
                         <div className="flex items-center gap-2">
@@ -203,7 +346,7 @@ export default function Settings() {
                     </div>
 
                     <p className="text-sm text-muted-foreground">
-                        Receive Telegram notifications for big trades and fresh wallet detections.
+                        Receive Telegram, email, Discord, Slack, webhook or desktop notifications for big trades and fresh wallet detections.
                         Create a bot via <a href="https://t.me/botfather" target="_blank" rel="noopener noreferrer" className="text-primary hover:underline">@BotFather</a> and get your Chat ID from <a href="https://t.me/userinfobot" target="_blank" rel="noopener noreferrer" className="text-primary hover:underline">@userinfobot</a>.
                     </p>
 
@@ -239,13 +382,223 @@ export default function Settings() {
                         </div>
                     </div>
 
+                    <div className="space-y-2">
+                        <label className="text-sm font-medium">Delivery</label>
+                        {NOTIFICATION_EVENTS.map(([eventType, label]) => (
+                            <div key={eventType} className="flex flex-wrap items-center gap-4 text-sm">
+                                <span className="w-28 text-muted-foreground">{label}</span>
+                                {NOTIFICATION_CHANNELS.map(([channel, channelLabel]) => (
+                                    <label key={channel} className="flex items-center gap-1.5 cursor-pointer">
+                                        <input
+                                            type="checkbox"
+                                            checked={channelsFor(eventType).includes(channel)}
+                                            onChange={() => toggleRoute(eventType, channel)}
+                                        />
+                                        {channelLabel}
+                                    </label>
+                                ))}
+                            </div>
+                        ))}
+                        <p className="text-xs text-muted-foreground">Desktop notifications are shown by the OS while XTools is open. Clicking one opens the market or wallet page.</p>
+                    </div>
+
+                    <div className="space-y-2">
+                        <label className="text-sm font-medium">Twitter Alerts</label>
+                        <div className="flex flex-wrap items-center gap-x-6 gap-y-2 text-sm">
+                            <label className="flex items-center gap-1.5 cursor-pointer">
+                                <input
+                                    type="checkbox"
+                                    checked={notifyApprovalQueue}
+                                    onChange={(e) => setNotifyApprovalQueue(e.target.checked)}
+                                />
+                                Replies waiting for approval
+                            </label>
+                            <label className="flex items-center gap-1.5 cursor-pointer">
+                                <input
+                                    type="checkbox"
+                                    checked={notifyAccountErrors}
+                                    onChange={(e) => setNotifyAccountErrors(e.target.checked)}
+                                />
+                                Expired cookies / auth failures
+                            </label>
+                            <label className="flex items-center gap-1.5 cursor-pointer">
+                                <input
+                                    type="checkbox"
+                                    checked={notifyReplyFailures}
+                                    onChange={(e) => setNotifyReplyFailures(e.target.checked)}
+                                />
+                                Reply failures after
+                            </label>
+                            <Input
+                                type="number"
+                                min={1}
+                                className="w-16 h-8"
+                                value={replyFailureThreshold}
+                                onChange={(e) => setReplyFailureThreshold(Math.max(1, parseInt(e.target.value) || 1))}
+                            />
+                            <span className="text-muted-foreground -ml-4">in a row</span>
+                        </div>
+                    </div>
+
+                    <div className="space-y-2">
+                        <label className="text-sm font-medium">Email (SMTP)</label>
+                        <div className="grid grid-cols-1 md:grid-cols-4 gap-4">
+                            <Input
+                                className="md:col-span-2"
+                                placeholder="smtp.example.com"
+                                value={smtp.host}
+                                onChange={(e) => setSmtp({ ...smtp, host: e.target.value })}
+                            />
+                            <Input
+                                type="number"
+                                placeholder="587"
+                                value={smtp.port || ''}
+                                onChange={(e) => setSmtp({ ...smtp, port: parseInt(e.target.value) || 0 })}
+                            />
+                            <select
+                                className="h-9 rounded-md border border-input bg-transparent px-3 text-sm"
+                                value={smtp.security}
+                                onChange={(e) => setSmtp({ ...smtp, security: e.target.value as SMTPSecurity })}
+                            >
+                                <option value="starttls">STARTTLS</option>
+                                <option value="tls">TLS</option>
+                                <option value="none">None</option>
+                            </select>
+                            <Input
+                                className="md:col-span-2"
+                                placeholder="Username (optional)"
+                                value={smtp.username}
+                                onChange={(e) => setSmtp({ ...smtp, username: e.target.value })}
+                            />
+                            <Input
+                                className="md:col-span-2"
+                                type="password"
+                                placeholder="Password"
+                                value={smtp.password}
+                                onChange={(e) => setSmtp({ ...smtp, password: e.target.value })}
+                            />
+                            <Input
+                                className="md:col-span-2"
+                                placeholder="XTools <alerts@example.com>"
+                                value={smtp.from}
+                                onChange={(e) => setSmtp({ ...smtp, from: e.target.value })}
+                            />
+                            <Input
+                                className="md:col-span-2"
+                                placeholder="alice@example.com, bob@example.com"
+                                value={emailRecipients}
+                                onChange={(e) => setEmailRecipients(e.target.value)}
+                            />
+                        </div>
+                        <div className="flex items-center gap-3 text-sm">
+                            <label className="flex items-center gap-1.5 cursor-pointer">
+                                <input
+                                    type="checkbox"
+                                    checked={digestEnabled}
+                                    onChange={(e) => setDigestEnabled(e.target.checked)}
+                                />
+                                Send daily digest at
+                            </label>
+                            <select
+                                className="h-8 rounded-md border border-input bg-transparent px-2 text-sm"
+                                value={digestHour}
+                                onChange={(e) => setDigestHour(parseInt(e.target.value))}
+                            >
+                                {Array.from({ length: 24 }, (_, h) => (
+                                    <option key={h} value={h}>{String(h).padStart(2, '0')}:00</option>
+                                ))}
+                            </select>
+                            <Button
+                                variant="secondary"
+                                size="sm"
+                                onClick={handleSendDigest}
+                                loading={isSendingDigest}
+                                disabled={!emailReady}
+                            >
+                                <Send size={16} />
+                                Send Digest Now
+                            </Button>
+                        </div>
+                        <p className="text-xs text-muted-foreground">The digest covers the last 24 hours: top trades, new fresh wallets and reply stats per account.</p>
+                    </div>
+
+                    <div className="space-y-2">
+                        <label className="text-sm font-medium">Webhooks</label>
+                        <div className="grid grid-cols-1 md:grid-cols-3 gap-4">
+                            <Input
+                                placeholder="Discord: https://discord.com/api/webhooks/..."
+                                value={discordWebhookURL}
+                                onChange={(e) => setDiscordWebhookURL(e.target.value)}
+                            />
+                            <Input
+                                placeholder="Slack: https://hooks.slack.com/services/..."
+                                value={slackWebhookURL}
+                                onChange={(e) => setSlackWebhookURL(e.target.value)}
+                            />
+                            <Input
+                                placeholder="Custom: https://example.com/hooks/xtools"
+                                value={webhookURL}
+                                onChange={(e) => setWebhookURL(e.target.value)}
+                            />
+                        </div>
+                        <p className="text-xs text-muted-foreground">Discord and Slack receive the Markdown template. The custom webhook receives the JSON template as the POST body.</p>
+                    </div>
+
+                    <div className="space-y-2">
+                        <label className="text-sm font-medium">Message Templates</label>
+                        <div className="flex flex-wrap items-center gap-3 text-sm">
+                            <select
+                                className="h-8 rounded-md border border-input bg-transparent px-2 text-sm"
+                                value={templateEvent}
+                                onChange={(e) => selectTemplate(e.target.value as NotificationEventType, templateChannel)}
+                            >
+                                {NOTIFICATION_EVENTS.map(([eventType, label]) => (
+                                    <option key={eventType} value={eventType}>{label}</option>
+                                ))}
+                            </select>
+                            <select
+                                className="h-8 rounded-md border border-input bg-transparent px-2 text-sm"
+                                value={templateChannel}
+                                onChange={(e) => selectTemplate(templateEvent, e.target.value)}
+                            >
+                                {NOTIFICATION_CHANNELS.map(([channel, label]) => (
+                                    <option key={channel} value={channel}>{label}</option>
+                                ))}
+                            </select>
+                            {customTemplate && <Badge variant="secondary">Customized</Badge>}
+                        </div>
+                        <Textarea
+                            value={templateBody}
+                            onChange={(e) => setTemplateBody(e.target.value)}
+                            className="min-h-[160px] font-mono text-xs"
+                            spellCheck={false}
+                        />
+                        <div className="flex items-center gap-2">
+                            <Button variant="secondary" size="sm" onClick={handlePreviewTemplate} loading={isPreviewing}>
+                                <Eye size={16} />
+                                Preview
+                            </Button>
+                            <Button variant="ghost" size="sm" onClick={() => setTemplateBody(defaultTemplateBody)} disabled={!customTemplate}>
+                                <RotateCcw size={16} />
+                                Reset to Default
+                            </Button>
+                        </div>
+                        {templateError && <p className="text-xs text-destructive">{templateError}</p>}
+                        {templatePreview !== null && (
+                            <pre className="bg-secondary px-3 py-2 rounded text-xs font-mono whitespace-pre-wrap break-words">{templatePreview}</pre>
+                        )}
+                        <p className="text-xs text-muted-foreground">
+                            Go text/template syntax, rendered against sample data. Helpers: esc, shortenAddr, money, number, marketLink, profileLink, formatTime. Templates are validated when you save.
+                        </p>
+                    </div>
+
                     <div className="flex items-center gap-2 pt-2">
                         <Button
                             variant="primary"
                             size="sm"
                             onClick={handleSaveNotificationConfig}
                             loading={isSavingNotification}
-                            disabled={!telegramBotToken || !telegramChatIDs.trim()}
+                            disabled={usesTelegram && !telegramReady}
                         >
                             <Check size={16} />
                             Save
@@ -255,7 +608,7 @@ export default function Settings() {
                             size="sm"
                             onClick={handleSendTestNotification}
                             loading={isSendingTest}
-                            disabled={!notificationsEnabled || !telegramBotToken || !telegramChatIDs.trim()}
+                            disabled={!notificationsEnabled || (usesTelegram && !telegramReady)}
                         >
                             <Send size={16} />
                             Send Test
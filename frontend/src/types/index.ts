@@ -47,6 +47,46 @@ export interface SearchConfig {
     minRetweets: number;
     maxAgeMins: number;
     intervalSecs: number;
+    maxPages?: number;
+    perKeyword?: boolean;
+    keywordGroups?: KeywordGroup[];
+    query?: SearchQuery;
+    minAuthorScore?: number; // 0-100, 0 = off
+    allowlist?: string[];
+}
+
+// Structured search operators; keywords are its any-of terms
+export interface SearchQuery {
+    anyWords?: string[];
+    allWords?: string[];
+    exactPhrases?: string[];
+    noneWords?: string[];
+    fromUsers?: string[];
+    toUsers?: string[];
+    mentions?: string[];
+    hashtags?: string[];
+    lang?: string;
+    hasMedia?: boolean;
+    hasLinks?: boolean;
+    verifiedOnly?: boolean;
+    since?: string; // YYYY-MM-DD
+    until?: string; // YYYY-MM-DD
+    minViews?: number;
+}
+
+export interface SearchFilters {
+    minFaves: number;
+    minReplies: number;
+    minRetweets: number;
+    maxAgeMins: number;
+}
+
+// Keywords searched as one query in per-keyword mode
+export interface KeywordGroup {
+    name: string;
+    keywords: string[];
+    filters?: SearchFilters; // Uses the account filters when unset
+    intervalSecs?: number; // 0 searches on every run
 }
 
 export interface ReplyConfig {
@@ -56,6 +96,8 @@ export interface ReplyConfig {
     tone: string;
     includeHashtags: boolean;
     signatureText?: string;
+    candidates?: number; // Variants generated for queued replies
+    undoWindowSecs?: number; // Auto mode: seconds a posted reply can be undone (0 = off)
 }
 
 export interface RateLimits {
@@ -65,6 +107,58 @@ export interface RateLimits {
     minDelayBetween: number;
 }
 
+export interface AlertBridgeConfig {
+    enabled: boolean;
+    minTradeValue: number;
+    dailyCap: number;
+    template?: string;
+}
+
+export interface RelevanceConfig {
+    enabled: boolean;
+    topic: string;
+    minScore: number; // 0-1
+    model?: string;
+}
+
+export interface PostingConfig {
+    jitterSecs: number;
+    timezone?: string;
+    activeStart: number; // 0-23
+    activeEnd: number; // 0-23, equal to start = all day
+    spreadDaily: boolean;
+}
+
+export interface GuardrailConfig {
+    enabled: boolean;
+    bannedWords?: string[];
+    bannedPatterns?: string[]; // regular expressions
+    allowLinks: boolean;
+    allowMentions: boolean;
+    maxHashtags: number; // 0 = no limit
+    duplicateThreshold: number; // 0-1, 0 = off
+    llmModeration: boolean;
+}
+
+export interface ExperimentVariant {
+    name: string;
+    weight: number; // relative share of replies
+    persona?: string; // empty = account persona
+    tone?: string; // empty = account tone
+    instructions?: string; // added to the reply prompt
+}
+
+export interface ExperimentConfig {
+    enabled: boolean;
+    variants: ExperimentVariant[];
+}
+
+export interface FollowUpConfig {
+    enabled: boolean;
+    maxDepth: number; // follow-ups allowed per conversation
+    intervalSecs?: number; // mentions check interval (0 = every 5 minutes)
+}
+
 export interface AccountConfig {
     id: string;
     username: string;
@@ -77,6 +171,12 @@ export interface AccountConfig {
     searchConfig: SearchConfig;
     replyConfig: ReplyConfig;
     rateLimits: RateLimits;
+    posting?: PostingConfig;
+    alertBridge: AlertBridgeConfig;
+    relevance?: RelevanceConfig;
+    guardrails?: GuardrailConfig;
+    experiment?: ExperimentConfig;
+    followUps?: FollowUpConfig;
 }
 
 export interface AccountStatus {
@@ -96,6 +196,12 @@ export interface Tweet {
     authorUsername: string;
     authorName: string;
     authorBio: string;
+    authorFollowers?: number;
+    authorFollowing?: number;
+    authorTweetCount?: number;
+    authorVerified?: boolean;
+    authorDefaultImage?: boolean;
+    authorCreatedAt?: string;
     text: string;
     createdAt: string;
     language: string;
@@ -109,6 +215,11 @@ export interface Tweet {
     matchedKeywords: string[];
     discoveredAt: string;
     accountId: string;
+    authorScore?: number; // 0-100
+    allowlisted?: boolean;
+    relevance?: number; // 0-1
+    sentiment?: string;
+    relevanceReason?: string;
 }
 
 export interface Reply {
@@ -122,6 +233,50 @@ export interface Reply {
     llmTokensUsed: number;
     errorMessage?: string;
     postedReplyId?: string;
+    type?: ReplyType;
+    media?: MediaAttachment[];
+    tweetAuthor?: string;
+    candidates?: ReplyCandidate[];
+    chosenCandidate: number;
+    scheduledFor?: string;
+    violations?: string[];
+    variant?: string;
+    followUpDepth?: number; // > 0 for follow-ups to replies to our replies
+    originalTweet?: Tweet; // Tweet replied to, in reply history
+}
+
+export interface ReplyCandidate {
+    text: string;
+    style?: string;
+    temperature: number;
+    tokensUsed: number;
+}
+
+// Image or GIF attached to a reply or tweet
+export interface MediaAttachment {
+    path: string;
+    mimeType?: string;
+    altText?: string;
+}
+
+// "tweet" marks a standalone tweet drafted by the app rather than a reply
+export type ReplyType = '' | 'tweet';
+
+export type ScheduledPostType = 'tweet' | 'quote' | 'thread';
+export type ScheduledPostStatus = 'scheduled' | 'posting' | 'posted' | 'failed' | 'cancelled';
+
+export interface ScheduledPost {
+    id: string;
+    accountId: string;
+    type: ScheduledPostType;
+    texts: string[];
+    quoteTweetId?: string;
+    scheduledAt: string;
+    status: ScheduledPostStatus;
+    createdAt: string;
+    postedAt?: string;
+    postedIds?: string[];
+    errorMessage?: string;
 }
 
 export interface ApprovalQueueItem {
@@ -129,6 +284,21 @@ export interface ApprovalQueueItem {
     originalTweet: Tweet;
     queuedAt: string;
     expiresAt: string;
+    priority: number; // 0-100
+}
+
+export type PendingSort = 'priority' | 'queued' | 'expires' | 'views' | 'relevance';
+
+export interface PendingFilter {
+    accountIds?: string[];
+    search?: string;
+    minPriority?: number;
+    sortBy?: PendingSort;
+}
+
+export interface BulkResult {
+    succeeded: string[];
+    failed: Record<string, string>;
 }
 
 // Metrics types
@@ -161,6 +331,28 @@ export interface ReplyMetrics {
     likeCount: number;
     retweetCount: number;
     impressions: number;
+    text?: string;
+    postedReplyId?: string;
+}
+
+export interface VariantResult {
+    name: string;
+    weight: number; // 0 if removed from the experiment
+    generated: number;
+    posted: number;
+    measured: number;
+    avgLikes: number;
+    avgImpressions: number;
+    likeLift: number; // relative to the baseline, 0.25 = +25%
+    pValue: number;
+    significance: 'baseline' | 'significant' | 'not significant' | 'too few replies';
+}
+
+export interface ExperimentResults {
+    accountId: string;
+    enabled: boolean;
+    checkpointHours: number;
+    variants: VariantResult[];
 }
 
 export interface DailyStats {
@@ -173,6 +365,18 @@ export interface DailyStats {
     tokensUsed: number;
 }
 
+// Search and reply performance of one keyword (or keyword group)
+export interface KeywordStats {
+    keyword: string;
+    tweetsFound: number;
+    repliesGenerated: number;
+    repliesPosted: number;
+    likes: number;
+    retweets: number;
+    impressions: number;
+    conversionRate: number;
+}
+
 // Activity log types
 export type ActivityType = string;
 export type ActivityLevel = string;
@@ -185,6 +389,8 @@ export interface ActivityLog {
     message: string;
     details?: string;
     timestamp: string;
+    replyId?: string;
+    undoUntil?: string; // Undo button shown in the feed until then
 }
 
 // Update types
@@ -314,12 +520,52 @@ export interface PolymarketConfig {
 
 // Notification types
 export type NotificationChannel = string;
+export type NotificationEventType = 'big_trade' | 'fresh_wallet' | 'approval_required' | 'reply_failed' | 'account_error' | 'test' | 'digest';
+
+export interface NotificationTemplate {
+    eventType: NotificationEventType;
+    channel: NotificationChannel;
+    body: string;
+}
+
+export type SMTPSecurity = 'none' | 'starttls' | 'tls';
+
+export interface SMTPConfig {
+    host: string;
+    port: number;
+    security: SMTPSecurity;
+    username: string;
+    password: string;
+    from: string;
+    recipients: string[];
+}
 
 export interface NotificationConfig {
     enabled: boolean;
     channel: NotificationChannel;
     telegramBotToken: string;
     telegramChatIDs: string[];
+    smtp: SMTPConfig;
+    discordWebhookURL: string;
+    slackWebhookURL: string;
+    webhookURL: string; // Receives the rendered JSON template
     notifyBigTrades: boolean;
     notifyFreshWallets: boolean;
+    notifyApprovalQueue: boolean;
+    notifyReplyFailures: boolean;
+    notifyAccountErrors: boolean;
+    replyFailureThreshold: number;
+    digestEnabled: boolean;
+    digestHour: number;
+    templates?: NotificationTemplate[];
+    // Channels each event type is delivered to (falls back to channel)
+    routes?: Partial<Record<NotificationEventType, NotificationChannel[]>>;
+}
+
+export interface DesktopNotificationEvent {
+    eventType: NotificationEventType;
+    title: string;
+    body: string;
+    link?: string;
+    priority: string;
 }
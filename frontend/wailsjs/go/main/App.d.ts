@@ -1,10 +1,14 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
-import {updater} from '../models';
 import {domain} from '../models';
+import {updater} from '../models';
+
+export function ApproveReplies(arg1:Array<string>):Promise<domain.BulkResult>;
 
 export function ApproveReply(arg1:string):Promise<void>;
 
+export function CancelScheduledPost(arg1:string):Promise<void>;
+
 export function CheckForUpdates():Promise<updater.UpdateInfo>;
 
 export function ClearActivityLogs(arg1:string):Promise<void>;
@@ -15,6 +19,10 @@ export function CreateAccount(arg1:domain.AccountConfig):Promise<void>;
 
 export function DeleteAccount(arg1:string):Promise<void>;
 
+export function DeleteReply(arg1:string):Promise<void>;
+
+export function DeleteScheduledPost(arg1:string):Promise<void>;
+
 export function EditReply(arg1:string,arg2:string):Promise<void>;
 
 export function ExportTweets(arg1:string,arg2:string):Promise<void>;
@@ -33,6 +41,8 @@ export function GetActivityLogs(arg1:string,arg2:number):Promise<Array<domain.Ac
 
 export function GetAllActivityLogs(arg1:number):Promise<Array<domain.ActivityLog>>;
 
+export function GetAllPendingReplies(arg1:domain.PendingFilter):Promise<Array<domain.ApprovalQueueItem>>;
+
 export function GetAppVersion():Promise<string>;
 
 export function GetBrowserPath():Promise<string>;
@@ -45,8 +55,14 @@ export function GetDataDir():Promise<string>;
 
 export function GetDatabaseInfo():Promise<domain.DatabaseInfo>;
 
+export function GetDefaultNotificationTemplates():Promise<Array<domain.NotificationTemplate>>;
+
+export function GetExperimentResults(arg1:string):Promise<domain.ExperimentResults>;
+
 export function GetExportPath(arg1:string):Promise<string>;
 
+export function GetKeywordStats(arg1:string,arg2:number):Promise<Array<domain.KeywordStats>>;
+
 export function GetNotificationConfig():Promise<domain.NotificationConfig>;
 
 export function GetPendingReplies(arg1:string):Promise<Array<domain.ApprovalQueueItem>>;
@@ -67,14 +83,28 @@ export function GetReplyHistory(arg1:string,arg2:number):Promise<Array<domain.Re
 
 export function GetReplyPerformance(arg1:string,arg2:number):Promise<domain.ReplyPerformanceReport>;
 
+export function GetScheduledPosts(arg1:string,arg2:number):Promise<Array<domain.ScheduledPost>>;
+
 export function GetTweetDetails(arg1:string,arg2:string):Promise<domain.Tweet>;
 
 export function GetWorkerStatus():Promise<Record<string, boolean>>;
 
+export function ImportUsernameList():Promise<Array<string>>;
+
 export function ManualSearch(arg1:string,arg2:string,arg3:number):Promise<Array<domain.Tweet>>;
 
 export function OpenFolder(arg1:string):Promise<void>;
 
+export function PostReplyNow(arg1:string):Promise<void>;
+
+export function PreviewNotificationTemplate(arg1:domain.NotificationTemplate):Promise<string>;
+
+export function PreviewSearchQuery(arg1:domain.AccountConfig):Promise<string>;
+
+export function PublishScheduledPost(arg1:string):Promise<void>;
+
+export function RejectReplies(arg1:Array<string>):Promise<domain.BulkResult>;
+
 export function RejectReply(arg1:string):Promise<void>;
 
 export function ReloadAccount(arg1:string):Promise<domain.AccountConfig>;
@@ -83,8 +113,16 @@ export function RestartAccount(arg1:string):Promise<void>;
 
 export function SaveBrowserAuth(arg1:string,arg2:domain.BrowserAuth):Promise<void>;
 
+export function SchedulePost(arg1:domain.ScheduledPost):Promise<domain.ScheduledPost>;
+
 export function SearchTweets(arg1:string):Promise<Array<domain.Tweet>>;
 
+export function SelectMediaFiles():Promise<Array<string>>;
+
+export function SelectReplyCandidate(arg1:string,arg2:number):Promise<void>;
+
+export function SendNotificationDigest():Promise<void>;
+
 export function SendTestNotification():Promise<void>;
 
 export function SetNotificationConfig(arg1:domain.NotificationConfig):Promise<void>;
@@ -93,6 +131,8 @@ export function SetPolymarketConfig(arg1:domain.PolymarketConfig):Promise<void>;
 
 export function SetPolymarketSaveFilter(arg1:domain.PolymarketEventFilter):Promise<void>;
 
+export function SetReplyMedia(arg1:string,arg2:Array<domain.MediaAttachment>):Promise<void>;
+
 export function StartAccount(arg1:string):Promise<void>;
 
 export function StartPolymarketWatcher():Promise<void>;
@@ -104,3 +144,5 @@ export function StopPolymarketWatcher():Promise<void>;
 export function TestAccountConnection(arg1:string):Promise<void>;
 
 export function UpdateAccount(arg1:domain.AccountConfig):Promise<void>;
+
+export function UpdateScheduledPost(arg1:domain.ScheduledPost):Promise<void>;
@@ -2,10 +2,18 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
+export function ApproveReplies(arg1) {
+  return window['go']['main']['App']['ApproveReplies'](arg1);
+}
+
 export function ApproveReply(arg1) {
   return window['go']['main']['App']['ApproveReply'](arg1);
 }
 
+export function CancelScheduledPost(arg1) {
+  return window['go']['main']['App']['CancelScheduledPost'](arg1);
+}
+
 export function CheckForUpdates() {
   return window['go']['main']['App']['CheckForUpdates']();
 }
@@ -26,6 +34,14 @@ export function DeleteAccount(arg1) {
   return window['go']['main']['App']['DeleteAccount'](arg1);
 }
 
+export function DeleteReply(arg1) {
+  return window['go']['main']['App']['DeleteReply'](arg1);
+}
+
+export function DeleteScheduledPost(arg1) {
+  return window['go']['main']['App']['DeleteScheduledPost'](arg1);
+}
+
 export function EditReply(arg1, arg2) {
   return window['go']['main']['App']['EditReply'](arg1, arg2);
 }
@@ -62,6 +78,10 @@ export function GetAllActivityLogs(arg1) {
   return window['go']['main']['App']['GetAllActivityLogs'](arg1);
 }
 
+export function GetAllPendingReplies(arg1) {
+  return window['go']['main']['App']['GetAllPendingReplies'](arg1);
+}
+
 export function GetAppVersion() {
   return window['go']['main']['App']['GetAppVersion']();
 }
@@ -86,10 +106,22 @@ export function GetDatabaseInfo() {
   return window['go']['main']['App']['GetDatabaseInfo']();
 }
 
+export function GetDefaultNotificationTemplates() {
+  return window['go']['main']['App']['GetDefaultNotificationTemplates']();
+}
+
+export function GetExperimentResults(arg1) {
+  return window['go']['main']['App']['GetExperimentResults'](arg1);
+}
+
 export function GetExportPath(arg1) {
   return window['go']['main']['App']['GetExportPath'](arg1);
 }
 
+export function GetKeywordStats(arg1, arg2) {
+  return window['go']['main']['App']['GetKeywordStats'](arg1, arg2);
+}
+
 export function GetNotificationConfig() {
   return window['go']['main']['App']['GetNotificationConfig']();
 }
@@ -130,6 +162,10 @@ export function GetReplyPerformance(arg1, arg2) {
   return window['go']['main']['App']['GetReplyPerformance'](arg1, arg2);
 }
 
+export function GetScheduledPosts(arg1, arg2) {
+  return window['go']['main']['App']['GetScheduledPosts'](arg1, arg2);
+}
+
 export function GetTweetDetails(arg1, arg2) {
   return window['go']['main']['App']['GetTweetDetails'](arg1, arg2);
 }
@@ -138,6 +174,10 @@ export function GetWorkerStatus() {
   return window['go']['main']['App']['GetWorkerStatus']();
 }
 
+export function ImportUsernameList() {
+  return window['go']['main']['App']['ImportUsernameList']();
+}
+
 export function ManualSearch(arg1, arg2, arg3) {
   return window['go']['main']['App']['ManualSearch'](arg1, arg2, arg3);
 }
@@ -146,6 +186,26 @@ export function OpenFolder(arg1) {
   return window['go']['main']['App']['OpenFolder'](arg1);
 }
 
+export function PostReplyNow(arg1) {
+  return window['go']['main']['App']['PostReplyNow'](arg1);
+}
+
+export function PreviewNotificationTemplate(arg1) {
+  return window['go']['main']['App']['PreviewNotificationTemplate'](arg1);
+}
+
+export function PreviewSearchQuery(arg1) {
+  return window['go']['main']['App']['PreviewSearchQuery'](arg1);
+}
+
+export function PublishScheduledPost(arg1) {
+  return window['go']['main']['App']['PublishScheduledPost'](arg1);
+}
+
+export function RejectReplies(arg1) {
+  return window['go']['main']['App']['RejectReplies'](arg1);
+}
+
 export function RejectReply(arg1) {
   return window['go']['main']['App']['RejectReply'](arg1);
 }
@@ -162,10 +222,26 @@ export function SaveBrowserAuth(arg1, arg2) {
   return window['go']['main']['App']['SaveBrowserAuth'](arg1, arg2);
 }
 
+export function SchedulePost(arg1) {
+  return window['go']['main']['App']['SchedulePost'](arg1);
+}
+
 export function SearchTweets(arg1) {
   return window['go']['main']['App']['SearchTweets'](arg1);
 }
 
+export function SelectMediaFiles() {
+  return window['go']['main']['App']['SelectMediaFiles']();
+}
+
+export function SelectReplyCandidate(arg1, arg2) {
+  return window['go']['main']['App']['SelectReplyCandidate'](arg1, arg2);
+}
+
+export function SendNotificationDigest() {
+  return window['go']['main']['App']['SendNotificationDigest']();
+}
+
 export function SendTestNotification() {
   return window['go']['main']['App']['SendTestNotification']();
 }
@@ -182,6 +258,10 @@ export function SetPolymarketSaveFilter(arg1) {
   return window['go']['main']['App']['SetPolymarketSaveFilter'](arg1);
 }
 
+export function SetReplyMedia(arg1, arg2) {
+  return window['go']['main']['App']['SetReplyMedia'](arg1, arg2);
+}
+
 export function StartAccount(arg1) {
   return window['go']['main']['App']['StartAccount'](arg1);
 }
@@ -205,3 +285,7 @@ export function TestAccountConnection(arg1) {
 export function UpdateAccount(arg1) {
   return window['go']['main']['App']['UpdateAccount'](arg1);
 }
+
+export function UpdateScheduledPost(arg1) {
+  return window['go']['main']['App']['UpdateScheduledPost'](arg1);
+}
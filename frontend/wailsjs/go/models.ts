@@ -20,6 +20,156 @@ export namespace domain {
 	        this.bearerToken = source["bearerToken"];
 	    }
 	}
+	export class FollowUpConfig {
+	    enabled: boolean;
+	    maxDepth: number;
+	    intervalSecs: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new FollowUpConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.enabled = source["enabled"];
+	        this.maxDepth = source["maxDepth"];
+	        this.intervalSecs = source["intervalSecs"];
+	    }
+	}
+	export class ExperimentVariant {
+	    name: string;
+	    weight: number;
+	    persona?: string;
+	    tone?: string;
+	    instructions?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ExperimentVariant(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.weight = source["weight"];
+	        this.persona = source["persona"];
+	        this.tone = source["tone"];
+	        this.instructions = source["instructions"];
+	    }
+	}
+	export class ExperimentConfig {
+	    enabled: boolean;
+	    variants: ExperimentVariant[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ExperimentConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.enabled = source["enabled"];
+	        this.variants = this.convertValues(source["variants"], ExperimentVariant);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class GuardrailConfig {
+	    enabled: boolean;
+	    bannedWords?: string[];
+	    bannedPatterns?: string[];
+	    allowLinks: boolean;
+	    allowMentions: boolean;
+	    maxHashtags: number;
+	    duplicateThreshold: number;
+	    llmModeration: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new GuardrailConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.enabled = source["enabled"];
+	        this.bannedWords = source["bannedWords"];
+	        this.bannedPatterns = source["bannedPatterns"];
+	        this.allowLinks = source["allowLinks"];
+	        this.allowMentions = source["allowMentions"];
+	        this.maxHashtags = source["maxHashtags"];
+	        this.duplicateThreshold = source["duplicateThreshold"];
+	        this.llmModeration = source["llmModeration"];
+	    }
+	}
+	export class RelevanceConfig {
+	    enabled: boolean;
+	    topic: string;
+	    minScore: number;
+	    model?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RelevanceConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.enabled = source["enabled"];
+	        this.topic = source["topic"];
+	        this.minScore = source["minScore"];
+	        this.model = source["model"];
+	    }
+	}
+	export class AlertBridgeConfig {
+	    enabled: boolean;
+	    minTradeValue: number;
+	    dailyCap: number;
+	    template?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new AlertBridgeConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.enabled = source["enabled"];
+	        this.minTradeValue = source["minTradeValue"];
+	        this.dailyCap = source["dailyCap"];
+	        this.template = source["template"];
+	    }
+	}
+	export class PostingConfig {
+	    jitterSecs: number;
+	    timezone?: string;
+	    activeStart: number;
+	    activeEnd: number;
+	    spreadDaily: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new PostingConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.jitterSecs = source["jitterSecs"];
+	        this.timezone = source["timezone"];
+	        this.activeStart = source["activeStart"];
+	        this.activeEnd = source["activeEnd"];
+	        this.spreadDaily = source["spreadDaily"];
+	    }
+	}
 	export class RateLimits {
 	    searchesPerHour: number;
 	    repliesPerHour: number;
@@ -45,6 +195,8 @@ export namespace domain {
 	    tone: string;
 	    includeHashtags: boolean;
 	    signatureText?: string;
+	    candidates?: number;
+	    undoWindowSecs?: number;
 	
 	    static createFrom(source: any = {}) {
 	        return new ReplyConfig(source);
@@ -58,6 +210,102 @@ export namespace domain {
 	        this.tone = source["tone"];
 	        this.includeHashtags = source["includeHashtags"];
 	        this.signatureText = source["signatureText"];
+	        this.candidates = source["candidates"];
+	        this.undoWindowSecs = source["undoWindowSecs"];
+	    }
+	}
+	export class SearchFilters {
+	    minFaves: number;
+	    minReplies: number;
+	    minRetweets: number;
+	    maxAgeMins: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SearchFilters(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.minFaves = source["minFaves"];
+	        this.minReplies = source["minReplies"];
+	        this.minRetweets = source["minRetweets"];
+	        this.maxAgeMins = source["maxAgeMins"];
+	    }
+	}
+	export class KeywordGroup {
+	    name: string;
+	    keywords: string[];
+	    filters?: SearchFilters;
+	    intervalSecs?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new KeywordGroup(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.keywords = source["keywords"];
+	        this.filters = this.convertValues(source["filters"], SearchFilters);
+	        this.intervalSecs = source["intervalSecs"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SearchQuery {
+	    anyWords?: string[];
+	    allWords?: string[];
+	    exactPhrases?: string[];
+	    noneWords?: string[];
+	    fromUsers?: string[];
+	    toUsers?: string[];
+	    mentions?: string[];
+	    hashtags?: string[];
+	    lang?: string;
+	    hasMedia?: boolean;
+	    hasLinks?: boolean;
+	    verifiedOnly?: boolean;
+	    since?: string;
+	    until?: string;
+	    minViews?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SearchQuery(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.anyWords = source["anyWords"];
+	        this.allWords = source["allWords"];
+	        this.exactPhrases = source["exactPhrases"];
+	        this.noneWords = source["noneWords"];
+	        this.fromUsers = source["fromUsers"];
+	        this.toUsers = source["toUsers"];
+	        this.mentions = source["mentions"];
+	        this.hashtags = source["hashtags"];
+	        this.lang = source["lang"];
+	        this.hasMedia = source["hasMedia"];
+	        this.hasLinks = source["hasLinks"];
+	        this.verifiedOnly = source["verifiedOnly"];
+	        this.since = source["since"];
+	        this.until = source["until"];
+	        this.minViews = source["minViews"];
 	    }
 	}
 	export class SearchConfig {
@@ -70,6 +318,12 @@ export namespace domain {
 	    minRetweets: number;
 	    maxAgeMins: number;
 	    intervalSecs: number;
+	    maxPages: number;
+	    query?: SearchQuery;
+	    minAuthorScore: number;
+	    allowlist?: string[];
+	    perKeyword: boolean;
+	    keywordGroups?: KeywordGroup[];
 	
 	    static createFrom(source: any = {}) {
 	        return new SearchConfig(source);
@@ -86,7 +340,31 @@ export namespace domain {
 	        this.minRetweets = source["minRetweets"];
 	        this.maxAgeMins = source["maxAgeMins"];
 	        this.intervalSecs = source["intervalSecs"];
+	        this.maxPages = source["maxPages"];
+	        this.query = this.convertValues(source["query"], SearchQuery);
+	        this.minAuthorScore = source["minAuthorScore"];
+	        this.allowlist = source["allowlist"];
+	        this.perKeyword = source["perKeyword"];
+	        this.keywordGroups = this.convertValues(source["keywordGroups"], KeywordGroup);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
 	export class LLMConfig {
 	    baseUrl: string;
@@ -180,6 +458,12 @@ export namespace domain {
 	    searchConfig: SearchConfig;
 	    replyConfig: ReplyConfig;
 	    rateLimits: RateLimits;
+	    posting: PostingConfig;
+	    alertBridge: AlertBridgeConfig;
+	    relevance: RelevanceConfig;
+	    guardrails: GuardrailConfig;
+	    experiment: ExperimentConfig;
+	    followUps: FollowUpConfig;
 	
 	    static createFrom(source: any = {}) {
 	        return new AccountConfig(source);
@@ -198,6 +482,12 @@ export namespace domain {
 	        this.searchConfig = this.convertValues(source["searchConfig"], SearchConfig);
 	        this.replyConfig = this.convertValues(source["replyConfig"], ReplyConfig);
 	        this.rateLimits = this.convertValues(source["rateLimits"], RateLimits);
+	        this.posting = this.convertValues(source["posting"], PostingConfig);
+	        this.alertBridge = this.convertValues(source["alertBridge"], AlertBridgeConfig);
+	        this.relevance = this.convertValues(source["relevance"], RelevanceConfig);
+	        this.guardrails = this.convertValues(source["guardrails"], GuardrailConfig);
+	        this.experiment = this.convertValues(source["experiment"], ExperimentConfig);
+	        this.followUps = this.convertValues(source["followUps"], FollowUpConfig);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -271,6 +561,9 @@ export namespace domain {
 	    details?: string;
 	    // Go type: time
 	    timestamp: any;
+	    replyId?: string;
+	    // Go type: time
+	    undoUntil?: any;
 	
 	    static createFrom(source: any = {}) {
 	        return new ActivityLog(source);
@@ -285,6 +578,8 @@ export namespace domain {
 	        this.message = source["message"];
 	        this.details = source["details"];
 	        this.timestamp = this.convertValues(source["timestamp"], null);
+	        this.replyId = source["replyId"];
+	        this.undoUntil = this.convertValues(source["undoUntil"], null);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -305,6 +600,25 @@ export namespace domain {
 		    return a;
 		}
 	}
+	
+	export class ReplyCandidate {
+	    text: string;
+	    style?: string;
+	    temperature: number;
+	    tokensUsed: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ReplyCandidate(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.text = source["text"];
+	        this.style = source["style"];
+	        this.temperature = source["temperature"];
+	        this.tokensUsed = source["tokensUsed"];
+	    }
+	}
 	export class Tweet {
 	    id: string;
 	    authorId: string;
@@ -315,6 +629,13 @@ export namespace domain {
 	    // Go type: time
 	    createdAt: any;
 	    language: string;
+	    authorFollowers?: number;
+	    authorFollowing?: number;
+	    authorTweetCount?: number;
+	    authorVerified?: boolean;
+	    authorDefaultImage?: boolean;
+	    // Go type: time
+	    authorCreatedAt?: any;
 	    likeCount: number;
 	    retweetCount: number;
 	    replyCount: number;
@@ -326,6 +647,11 @@ export namespace domain {
 	    // Go type: time
 	    discoveredAt: any;
 	    accountId: string;
+	    authorScore?: number;
+	    allowlisted?: boolean;
+	    relevance?: number;
+	    sentiment?: string;
+	    relevanceReason?: string;
 	
 	    static createFrom(source: any = {}) {
 	        return new Tweet(source);
@@ -341,6 +667,12 @@ export namespace domain {
 	        this.text = source["text"];
 	        this.createdAt = this.convertValues(source["createdAt"], null);
 	        this.language = source["language"];
+	        this.authorFollowers = source["authorFollowers"];
+	        this.authorFollowing = source["authorFollowing"];
+	        this.authorTweetCount = source["authorTweetCount"];
+	        this.authorVerified = source["authorVerified"];
+	        this.authorDefaultImage = source["authorDefaultImage"];
+	        this.authorCreatedAt = this.convertValues(source["authorCreatedAt"], null);
 	        this.likeCount = source["likeCount"];
 	        this.retweetCount = source["retweetCount"];
 	        this.replyCount = source["replyCount"];
@@ -351,6 +683,11 @@ export namespace domain {
 	        this.matchedKeywords = source["matchedKeywords"];
 	        this.discoveredAt = this.convertValues(source["discoveredAt"], null);
 	        this.accountId = source["accountId"];
+	        this.authorScore = source["authorScore"];
+	        this.allowlisted = source["allowlisted"];
+	        this.relevance = source["relevance"];
+	        this.sentiment = source["sentiment"];
+	        this.relevanceReason = source["relevanceReason"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -371,6 +708,22 @@ export namespace domain {
 		    return a;
 		}
 	}
+	export class MediaAttachment {
+	    path: string;
+	    mimeType?: string;
+	    altText?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new MediaAttachment(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.mimeType = source["mimeType"];
+	        this.altText = source["altText"];
+	    }
+	}
 	export class Reply {
 	    id: string;
 	    tweetId: string;
@@ -384,6 +737,17 @@ export namespace domain {
 	    llmTokensUsed: number;
 	    errorMessage?: string;
 	    postedReplyId?: string;
+	    type?: string;
+	    media?: MediaAttachment[];
+	    tweetAuthor?: string;
+	    // Go type: time
+	    scheduledFor?: any;
+	    violations?: string[];
+	    variant?: string;
+	    originalTweet?: Tweet;
+	    followUpDepth?: number;
+	    candidates?: ReplyCandidate[];
+	    chosenCandidate: number;
 	
 	    static createFrom(source: any = {}) {
 	        return new Reply(source);
@@ -401,6 +765,16 @@ export namespace domain {
 	        this.llmTokensUsed = source["llmTokensUsed"];
 	        this.errorMessage = source["errorMessage"];
 	        this.postedReplyId = source["postedReplyId"];
+	        this.type = source["type"];
+	        this.media = this.convertValues(source["media"], MediaAttachment);
+	        this.tweetAuthor = source["tweetAuthor"];
+	        this.scheduledFor = this.convertValues(source["scheduledFor"], null);
+	        this.violations = source["violations"];
+	        this.variant = source["variant"];
+	        this.originalTweet = this.convertValues(source["originalTweet"], Tweet);
+	        this.followUpDepth = source["followUpDepth"];
+	        this.candidates = this.convertValues(source["candidates"], ReplyCandidate);
+	        this.chosenCandidate = source["chosenCandidate"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -428,6 +802,7 @@ export namespace domain {
 	    queuedAt: any;
 	    // Go type: time
 	    expiresAt: any;
+	    priority: number;
 	
 	    static createFrom(source: any = {}) {
 	        return new ApprovalQueueItem(source);
@@ -439,6 +814,7 @@ export namespace domain {
 	        this.originalTweet = this.convertValues(source["originalTweet"], Tweet);
 	        this.queuedAt = this.convertValues(source["queuedAt"], null);
 	        this.expiresAt = this.convertValues(source["expiresAt"], null);
+	        this.priority = source["priority"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -460,6 +836,20 @@ export namespace domain {
 		}
 	}
 	
+	export class BulkResult {
+	    succeeded: string[];
+	    failed: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new BulkResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.succeeded = source["succeeded"];
+	        this.failed = source["failed"];
+	    }
+	}
 	
 	export class DailyStats {
 	    accountId: string;
@@ -522,6 +912,75 @@ export namespace domain {
 	        this.path = source["path"];
 	    }
 	}
+	
+	export class VariantResult {
+	    name: string;
+	    weight: number;
+	    generated: number;
+	    posted: number;
+	    measured: number;
+	    avgLikes: number;
+	    avgImpressions: number;
+	    likeLift: number;
+	    pValue: number;
+	    significance: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new VariantResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.weight = source["weight"];
+	        this.generated = source["generated"];
+	        this.posted = source["posted"];
+	        this.measured = source["measured"];
+	        this.avgLikes = source["avgLikes"];
+	        this.avgImpressions = source["avgImpressions"];
+	        this.likeLift = source["likeLift"];
+	        this.pValue = source["pValue"];
+	        this.significance = source["significance"];
+	    }
+	}
+	export class ExperimentResults {
+	    accountId: string;
+	    enabled: boolean;
+	    checkpointHours: number;
+	    variants: VariantResult[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ExperimentResults(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accountId = source["accountId"];
+	        this.enabled = source["enabled"];
+	        this.checkpointHours = source["checkpointHours"];
+	        this.variants = this.convertValues(source["variants"], VariantResult);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
 	export class FreshWalletSignal {
 	    confidence: number;
 	    factors: Record<string, number>;
@@ -539,13 +998,94 @@ export namespace domain {
 	    }
 	}
 	
+	
+	export class KeywordStats {
+	    keyword: string;
+	    tweetsFound: number;
+	    repliesGenerated: number;
+	    repliesPosted: number;
+	    likes: number;
+	    retweets: number;
+	    impressions: number;
+	    conversionRate: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new KeywordStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.keyword = source["keyword"];
+	        this.tweetsFound = source["tweetsFound"];
+	        this.repliesGenerated = source["repliesGenerated"];
+	        this.repliesPosted = source["repliesPosted"];
+	        this.likes = source["likes"];
+	        this.retweets = source["retweets"];
+	        this.impressions = source["impressions"];
+	        this.conversionRate = source["conversionRate"];
+	    }
+	}
+	
+	
+	export class NotificationTemplate {
+	    eventType: string;
+	    channel: string;
+	    body: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new NotificationTemplate(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.eventType = source["eventType"];
+	        this.channel = source["channel"];
+	        this.body = source["body"];
+	    }
+	}
+	export class SMTPConfig {
+	    host: string;
+	    port: number;
+	    security: string;
+	    username: string;
+	    password: string;
+	    from: string;
+	    recipients: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SMTPConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.host = source["host"];
+	        this.port = source["port"];
+	        this.security = source["security"];
+	        this.username = source["username"];
+	        this.password = source["password"];
+	        this.from = source["from"];
+	        this.recipients = source["recipients"];
+	    }
+	}
 	export class NotificationConfig {
 	    enabled: boolean;
 	    channel: string;
 	    telegramBotToken: string;
 	    telegramChatIDs: string[];
+	    smtp: SMTPConfig;
+	    discordWebhookURL: string;
+	    slackWebhookURL: string;
+	    webhookURL: string;
 	    notifyBigTrades: boolean;
 	    notifyFreshWallets: boolean;
+	    notifyApprovalQueue: boolean;
+	    notifyReplyFailures: boolean;
+	    notifyAccountErrors: boolean;
+	    replyFailureThreshold: number;
+	    digestEnabled: boolean;
+	    digestHour: number;
+	    templates?: NotificationTemplate[];
+	    routes?: Record<string, Array<string>>;
 	
 	    static createFrom(source: any = {}) {
 	        return new NotificationConfig(source);
@@ -557,8 +1097,57 @@ export namespace domain {
 	        this.channel = source["channel"];
 	        this.telegramBotToken = source["telegramBotToken"];
 	        this.telegramChatIDs = source["telegramChatIDs"];
+	        this.smtp = this.convertValues(source["smtp"], SMTPConfig);
+	        this.discordWebhookURL = source["discordWebhookURL"];
+	        this.slackWebhookURL = source["slackWebhookURL"];
+	        this.webhookURL = source["webhookURL"];
 	        this.notifyBigTrades = source["notifyBigTrades"];
 	        this.notifyFreshWallets = source["notifyFreshWallets"];
+	        this.notifyApprovalQueue = source["notifyApprovalQueue"];
+	        this.notifyReplyFailures = source["notifyReplyFailures"];
+	        this.notifyAccountErrors = source["notifyAccountErrors"];
+	        this.replyFailureThreshold = source["replyFailureThreshold"];
+	        this.digestEnabled = source["digestEnabled"];
+	        this.digestHour = source["digestHour"];
+	        this.templates = this.convertValues(source["templates"], NotificationTemplate);
+	        this.routes = source["routes"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class PendingFilter {
+	    accountIds?: string[];
+	    search?: string;
+	    minPriority?: number;
+	    sortBy?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PendingFilter(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accountIds = source["accountIds"];
+	        this.search = source["search"];
+	        this.minPriority = source["minPriority"];
+	        this.sortBy = source["sortBy"];
 	    }
 	}
 	export class PolymarketConfig {
@@ -748,6 +1337,9 @@ export namespace domain {
 	    freshWalletsOnly?: boolean;
 	    minRiskScore?: number;
 	    maxWalletNonce?: number;
+	    // Go type: time
+	    since?: any;
+	    sortByValue?: boolean;
 	
 	    static createFrom(source: any = {}) {
 	        return new PolymarketEventFilter(source);
@@ -766,7 +1358,27 @@ export namespace domain {
 	        this.freshWalletsOnly = source["freshWalletsOnly"];
 	        this.minRiskScore = source["minRiskScore"];
 	        this.maxWalletNonce = source["maxWalletNonce"];
+	        this.since = this.convertValues(source["since"], null);
+	        this.sortByValue = source["sortByValue"];
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
 	export class PolymarketWatcherStatus {
 	    isRunning: boolean;
@@ -818,6 +1430,7 @@ export namespace domain {
 		    return a;
 		}
 	}
+	
 	export class ProfileSnapshot {
 	    accountId: string;
 	    // Go type: time
@@ -862,6 +1475,8 @@ export namespace domain {
 	
 	
 	
+	
+	
 	export class ReplyMetrics {
 	    replyId: string;
 	    accountId: string;
@@ -871,6 +1486,8 @@ export namespace domain {
 	    likeCount: number;
 	    retweetCount: number;
 	    impressions: number;
+	    text?: string;
+	    postedReplyId?: string;
 	
 	    static createFrom(source: any = {}) {
 	        return new ReplyMetrics(source);
@@ -885,6 +1502,8 @@ export namespace domain {
 	        this.likeCount = source["likeCount"];
 	        this.retweetCount = source["retweetCount"];
 	        this.impressions = source["impressions"];
+	        this.text = source["text"];
+	        this.postedReplyId = source["postedReplyId"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -952,6 +1571,63 @@ export namespace domain {
 		}
 	}
 	
+	export class ScheduledPost {
+	    id: string;
+	    accountId: string;
+	    type: string;
+	    texts: string[];
+	    quoteTweetId?: string;
+	    // Go type: time
+	    scheduledAt: any;
+	    status: string;
+	    // Go type: time
+	    createdAt: any;
+	    // Go type: time
+	    postedAt?: any;
+	    postedIds?: string[];
+	    errorMessage?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ScheduledPost(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.accountId = source["accountId"];
+	        this.type = source["type"];
+	        this.texts = source["texts"];
+	        this.quoteTweetId = source["quoteTweetId"];
+	        this.scheduledAt = this.convertValues(source["scheduledAt"], null);
+	        this.status = source["status"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.postedAt = this.convertValues(source["postedAt"], null);
+	        this.postedIds = source["postedIds"];
+	        this.errorMessage = source["errorMessage"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	
+	
 	
 
 }
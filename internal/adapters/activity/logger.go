@@ -30,19 +30,23 @@ func NewInMemoryLogger(eventBus ports.EventBus) *InMemoryLogger {
 
 // Log adds a new activity entry
 func (l *InMemoryLogger) Log(accountID string, actType domain.ActivityType, level domain.ActivityLevel, message string, details string) {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-
-	l.counter++
-	entry := domain.ActivityLog{
-		ID:        fmt.Sprintf("%d-%d", time.Now().UnixNano(), l.counter),
+	l.LogEntry(domain.ActivityLog{
 		AccountID: accountID,
 		Type:      actType,
 		Level:     level,
 		Message:   message,
 		Details:   details,
-		Timestamp: time.Now(),
-	}
+	})
+}
+
+// LogEntry adds a prepared entry; ID and Timestamp are filled in
+func (l *InMemoryLogger) LogEntry(entry domain.ActivityLog) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.counter++
+	entry.ID = fmt.Sprintf("%d-%d", time.Now().UnixNano(), l.counter)
+	entry.Timestamp = time.Now()
 
 	// Prepend (newest first)
 	l.logs = append([]domain.ActivityLog{entry}, l.logs...)
@@ -58,7 +62,7 @@ func (l *InMemoryLogger) Log(accountID string, actType domain.ActivityType, leve
 	}
 
 	// Also print to console for debugging
-	fmt.Printf("[%s] [%s] %s: %s\n", level, actType, accountID, message)
+	fmt.Printf("[%s] [%s] %s: %s\n", entry.Level, entry.Type, entry.AccountID, entry.Message)
 }
 
 // GetLogs retrieves logs for an account (newest first)
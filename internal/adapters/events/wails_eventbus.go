@@ -2,6 +2,7 @@ package events
 
 import (
 	"context"
+	"reflect"
 	"sync"
 
 	"github.com/wailsapp/wails/v2/pkg/runtime"
@@ -13,14 +14,19 @@ import (
 type WailsEventBus struct {
 	ctx       context.Context
 	mu        sync.RWMutex
-	handlers  map[string][]ports.EventHandler
+	handlers  map[string][]*subscription
+}
+
+// subscription is one Subscribe call; its address identifies it for removal
+type subscription struct {
+	handler ports.EventHandler
 }
 
 // NewWailsEventBus creates a new Wails-based event bus
 func NewWailsEventBus(ctx context.Context) *WailsEventBus {
 	return &WailsEventBus{
 		ctx:      ctx,
-		handlers: make(map[string][]ports.EventHandler),
+		handlers: make(map[string][]*subscription),
 	}
 }
 
@@ -63,24 +69,32 @@ func (e *WailsEventBus) Subscribe(eventName string, handler ports.EventHandler)
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
-	e.handlers[eventName] = append(e.handlers[eventName], handler)
+	sub := &subscription{handler: handler}
+	e.handlers[eventName] = append(e.handlers[eventName], sub)
 
 	// Return unsubscribe function
 	return func() {
-		e.Unsubscribe(eventName, handler)
+		e.remove(eventName, func(s *subscription) bool { return s == sub })
 	}
 }
 
-// Unsubscribe removes an event handler
+// Unsubscribe removes an event handler. Func values can't be compared, so this
+// matches on the function's code pointer: method values of the same method on
+// different receivers look alike. Prefer the func returned by Subscribe.
 func (e *WailsEventBus) Unsubscribe(eventName string, handler ports.EventHandler) {
+	ptr := reflect.ValueOf(handler).Pointer()
+	e.remove(eventName, func(s *subscription) bool { return reflect.ValueOf(s.handler).Pointer() == ptr })
+}
+
+// remove drops the first subscription to an event that matches
+func (e *WailsEventBus) remove(eventName string, match func(*subscription) bool) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
-	handlers := e.handlers[eventName]
-	for i, h := range handlers {
-		// Compare function pointers (works for same reference)
-		if &h == &handler {
-			e.handlers[eventName] = append(handlers[:i], handlers[i+1:]...)
+	subs := e.handlers[eventName]
+	for i, s := range subs {
+		if match(s) {
+			e.handlers[eventName] = append(subs[:i:i], subs[i+1:]...)
 			break
 		}
 	}
@@ -88,12 +102,12 @@ func (e *WailsEventBus) Unsubscribe(eventName string, handler ports.EventHandler
 
 func (e *WailsEventBus) notifyHandlers(eventName string, data interface{}) {
 	e.mu.RLock()
-	handlers := make([]ports.EventHandler, len(e.handlers[eventName]))
-	copy(handlers, e.handlers[eventName])
+	subs := make([]*subscription, len(e.handlers[eventName]))
+	copy(subs, e.handlers[eventName])
 	e.mu.RUnlock()
 
-	for _, h := range handlers {
-		go h(data) // Run handlers in goroutines to avoid blocking
+	for _, s := range subs {
+		go s.handler(data) // Run handlers in goroutines to avoid blocking
 	}
 }
 
@@ -0,0 +1,98 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"xtools/internal/ports"
+)
+
+// Classification output budget per tweet in a batch
+const classifyTokensPerTweet = 80
+
+const classifySystemPrompt = `You screen social media posts for an account that only wants to reply to posts about its topic.
+
+For each post, judge:
+- relevance: a number from 0 to 1. 1 means the post is squarely about the topic, 0 means it only shares a keyword or is spam.
+- sentiment: the post's tone, one of "positive", "neutral" or "negative".
+- reason: a few words explaining the relevance score.
+
+Respond with a JSON array only, one object per post, in the form:
+[{"id": "<post id>", "relevance": 0.8, "sentiment": "neutral", "reason": "..."}]`
+
+// ClassifyTweets scores the relevance and sentiment of tweets against a topic in one request
+func (c *OpenAIClient) ClassifyTweets(ctx context.Context, req ports.ClassifyRequest) (*ports.ClassifyResponse, error) {
+	if len(req.Tweets) == 0 {
+		return &ports.ClassifyResponse{}, nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("## Topic\n")
+	sb.WriteString(req.Topic)
+	sb.WriteString("\n\n## Posts\n")
+	for _, t := range req.Tweets {
+		sb.WriteString(fmt.Sprintf("- id %s, @%s: %s\n", t.ID, t.AuthorUsername, truncateText(strings.ReplaceAll(t.Text, "\n", " "), maxPromptTweetLength)))
+	}
+
+	text, tokensUsed, err := c.chat(ctx, ChatRequest{
+		Model:       c.config.Model,
+		MaxTokens:   classifyTokensPerTweet*len(req.Tweets) + 50,
+		Temperature: 0.1,
+		Messages: []ChatMessage{
+			{Role: "system", Content: classifySystemPrompt},
+			{Role: "user", Content: sb.String()},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := parseClassifications(text)
+	if err != nil {
+		return nil, err
+	}
+	return &ports.ClassifyResponse{Results: results, TokensUsed: tokensUsed}, nil
+}
+
+// parseClassifications reads the JSON array of a classification response,
+// tolerating code fences and text around it
+func parseClassifications(text string) ([]ports.TweetClassification, error) {
+	start, end := strings.Index(text, "["), strings.LastIndex(text, "]")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("classification response has no JSON array")
+	}
+
+	var raw []struct {
+		ID        json.RawMessage `json:"id"`
+		Relevance float64         `json:"relevance"`
+		Sentiment string          `json:"sentiment"`
+		Reason    string          `json:"reason"`
+	}
+	if err := json.Unmarshal([]byte(text[start:end+1]), &raw); err != nil {
+		return nil, fmt.Errorf("invalid classification response: %w", err)
+	}
+
+	results := make([]ports.TweetClassification, 0, len(raw))
+	for _, r := range raw {
+		// Models sometimes return IDs as numbers
+		id := strings.Trim(string(r.ID), `"`)
+		if id == "" {
+			continue
+		}
+		relevance := r.Relevance
+		if relevance < 0 {
+			relevance = 0
+		} else if relevance > 1 {
+			relevance = 1
+		}
+		results = append(results, ports.TweetClassification{
+			TweetID:   id,
+			Relevance: relevance,
+			Sentiment: strings.ToLower(strings.TrimSpace(r.Sentiment)),
+			Reason:    strings.TrimSpace(r.Reason),
+		})
+	}
+	return results, nil
+}
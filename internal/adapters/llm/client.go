@@ -14,6 +14,13 @@ import (
 	"xtools/internal/ports"
 )
 
+// Thread context limits for the reply prompt
+const (
+	maxPromptParents     = 5   // Closest ancestors of the tweet
+	maxPromptReplies     = 3   // Top replies to the tweet
+	maxPromptTweetLength = 280 // Characters kept per context tweet
+)
+
 // OpenAIClient implements LLMProvider for OpenAI-compatible APIs
 type OpenAIClient struct {
 	config     domain.LLMConfig
@@ -91,29 +98,60 @@ type APIError struct {
 
 // GenerateReply generates a reply for a tweet
 func (c *OpenAIClient) GenerateReply(ctx context.Context, req ports.ReplyRequest) (*ports.ReplyResponse, error) {
-	systemPrompt := c.buildSystemPrompt(req)
-	userPrompt := c.buildUserPrompt(req)
+	return c.complete(ctx, c.buildSystemPrompt(req), c.buildUserPrompt(req), req.MaxLength, req.Temperature)
+}
+
+// GenerateTweet writes a standalone tweet from a prompt
+func (c *OpenAIClient) GenerateTweet(ctx context.Context, req ports.TweetRequest) (*ports.ReplyResponse, error) {
+	return c.complete(ctx, c.buildTweetSystemPrompt(req), c.buildTweetUserPrompt(req), req.MaxLength, 0)
+}
 
-	chatReq := ChatRequest{
+// complete runs a chat completion and trims the result to maxLength. A zero
+// temperature uses the configured one.
+func (c *OpenAIClient) complete(ctx context.Context, systemPrompt, userPrompt string, maxLength int, temperature float64) (*ports.ReplyResponse, error) {
+	if temperature <= 0 {
+		temperature = c.config.Temperature
+	}
+
+	replyText, tokensUsed, err := c.chat(ctx, ChatRequest{
 		Model:       c.config.Model,
 		MaxTokens:   c.config.MaxTokens,
-		Temperature: c.config.Temperature,
+		Temperature: temperature,
 		Messages: []ChatMessage{
 			{Role: "system", Content: systemPrompt},
 			{Role: "user", Content: userPrompt},
 		},
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	// Ensure reply fits character limit
+	if len(replyText) > maxLength && maxLength > 0 {
+		replyText = c.truncateReply(replyText, maxLength)
+	}
+
+	return &ports.ReplyResponse{
+		Text:       replyText,
+		Confidence: 1.0,
+		TokensUsed: tokensUsed,
+		Model:      c.config.Model,
+		Fallback:   false,
+	}, nil
+}
+
+// chat sends a chat completion request and returns the first choice's text
+func (c *OpenAIClient) chat(ctx context.Context, chatReq ChatRequest) (string, int, error) {
 	body, err := json.Marshal(chatReq)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
+		return "", 0, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
 	httpReq, err := http.NewRequestWithContext(ctx, "POST",
 		strings.TrimSuffix(c.config.BaseURL, "/")+"/chat/completions",
 		bytes.NewReader(body))
 	if err != nil {
-		return nil, err
+		return "", 0, err
 	}
 
 	httpReq.Header.Set("Authorization", "Bearer "+c.config.APIKey)
@@ -121,33 +159,26 @@ func (c *OpenAIClient) GenerateReply(ctx context.Context, req ports.ReplyRequest
 
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return "", 0, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return "", 0, fmt.Errorf("failed to read response: %w", err)
 	}
 
 	var chatResp ChatResponse
 	if err := json.Unmarshal(respBody, &chatResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+		return "", 0, fmt.Errorf("failed to parse response: %w", err)
 	}
 
 	if chatResp.Error != nil {
-		return nil, fmt.Errorf("API error: %s", chatResp.Error.Message)
+		return "", 0, fmt.Errorf("API error: %s", chatResp.Error.Message)
 	}
 
 	if len(chatResp.Choices) == 0 {
-		return nil, fmt.Errorf("no response generated")
-	}
-
-	replyText := strings.TrimSpace(chatResp.Choices[0].Message.Content)
-
-	// Ensure reply fits character limit
-	if len(replyText) > req.MaxLength && req.MaxLength > 0 {
-		replyText = c.truncateReply(replyText, req.MaxLength)
+		return "", 0, fmt.Errorf("no response generated")
 	}
 
 	tokensUsed := 0
@@ -155,16 +186,13 @@ func (c *OpenAIClient) GenerateReply(ctx context.Context, req ports.ReplyRequest
 		tokensUsed = chatResp.Usage.TotalTokens
 	}
 
-	return &ports.ReplyResponse{
-		Text:       replyText,
-		Confidence: 1.0,
-		TokensUsed: tokensUsed,
-		Model:      c.config.Model,
-		Fallback:   false,
-	}, nil
+	return strings.TrimSpace(chatResp.Choices[0].Message.Content), tokensUsed, nil
 }
 
 func (c *OpenAIClient) buildSystemPrompt(req ports.ReplyRequest) string {
+	if req.AccountPersona != "" {
+		return req.AccountPersona
+	}
 	if c.config.Persona != "" {
 		return c.config.Persona
 	}
@@ -203,14 +231,25 @@ func (c *OpenAIClient) buildUserPrompt(req ports.ReplyRequest) string {
 		sb.WriteString(fmt.Sprintf("%s\n\n", req.AuthorBio))
 	}
 
-	// Thread context
-	if len(req.ThreadContext) > 0 {
-		sb.WriteString("## Thread Context\n")
-		for i, t := range req.ThreadContext {
-			if i >= 3 {
-				break
-			}
-			sb.WriteString(fmt.Sprintf("- @%s: %s\n", t.AuthorUsername, t.Text))
+	// Thread context: the closest parents and the top replies, each shortened
+	parents, replies := splitThread(req.ThreadContext, req.OriginalTweet.ID)
+	if len(parents) > maxPromptParents {
+		parents = parents[len(parents)-maxPromptParents:]
+	}
+	if len(replies) > maxPromptReplies {
+		replies = replies[:maxPromptReplies]
+	}
+	if len(parents) > 0 {
+		sb.WriteString("## Thread Context (earlier posts this replies to)\n")
+		for _, t := range parents {
+			sb.WriteString(fmt.Sprintf("- @%s: %s\n", t.AuthorUsername, truncateText(t.Text, maxPromptTweetLength)))
+		}
+		sb.WriteString("\n")
+	}
+	if len(replies) > 0 {
+		sb.WriteString("## Top Replies\n")
+		for _, t := range replies {
+			sb.WriteString(fmt.Sprintf("- @%s: %s\n", t.AuthorUsername, truncateText(t.Text, maxPromptTweetLength)))
 		}
 		sb.WriteString("\n")
 	}
@@ -228,11 +267,61 @@ func (c *OpenAIClient) buildUserPrompt(req ports.ReplyRequest) string {
 		maxLen = 260
 	}
 	sb.WriteString(fmt.Sprintf("Write a reply to this post in %d characters or less.\n", maxLen))
+	if req.Instructions != "" {
+		sb.WriteString(req.Instructions + "\n")
+	}
+	if req.Style != "" {
+		sb.WriteString(req.Style + "\n")
+	}
 	sb.WriteString("Output only the reply text, nothing else.")
 
 	return sb.String()
 }
 
+func (c *OpenAIClient) buildTweetSystemPrompt(req ports.TweetRequest) string {
+	if req.AccountPersona != "" {
+		return req.AccountPersona
+	}
+	if c.config.Persona != "" {
+		return c.config.Persona
+	}
+
+	prompt := `You are a Twitter account that posts short, factual updates.
+
+Guidelines:
+- Keep tweets under 280 characters
+- Be accurate and don't exaggerate
+- Don't give financial advice`
+
+	if req.Tone != "" {
+		prompt += fmt.Sprintf("\n- Maintain a %s tone", req.Tone)
+	}
+
+	if req.IncludeHashtags {
+		prompt += "\n- Include relevant hashtags when appropriate"
+	}
+
+	return prompt
+}
+
+func (c *OpenAIClient) buildTweetUserPrompt(req ports.TweetRequest) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Topic\n")
+	sb.WriteString(req.Prompt)
+	sb.WriteString("\n\n")
+
+	sb.WriteString("## Task\n")
+	maxLen := req.MaxLength
+	if maxLen <= 0 {
+		maxLen = 280
+	}
+	sb.WriteString(fmt.Sprintf("Write a tweet about this in %d characters or less.\n", maxLen))
+	sb.WriteString("Output only the tweet text, nothing else.")
+
+	return sb.String()
+}
+
 func (c *OpenAIClient) truncateReply(text string, maxLen int) string {
 	if len(text) <= maxLen {
 		return text
@@ -248,6 +337,27 @@ func (c *OpenAIClient) truncateReply(text string, maxLen int) string {
 	return truncated + "..."
 }
 
+// splitThread separates a thread into the posts before the tweet and its replies.
+// Without the tweet in the thread, all posts are treated as earlier context.
+func splitThread(thread []domain.Tweet, tweetID string) (parents, replies []domain.Tweet) {
+	for i, t := range thread {
+		if t.ID == tweetID {
+			return thread[:i], thread[i+1:]
+		}
+	}
+	return thread, nil
+}
+
+// truncateText shortens text to maxLen characters
+func truncateText(text string, maxLen int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	runes := []rune(text)
+	if len(runes) <= maxLen {
+		return text
+	}
+	return string(runes[:maxLen-3]) + "..."
+}
+
 // ValidateConfig checks if the configuration is valid
 func (c *OpenAIClient) ValidateConfig() error {
 	if c.config.APIKey == "" {
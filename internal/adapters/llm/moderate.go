@@ -0,0 +1,73 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"xtools/internal/ports"
+)
+
+const moderateSystemPrompt = `You review social media replies written by an automated account before they are posted.
+
+Flag the reply if it contains any of:
+- insults, harassment, hate or threats
+- sexual content or profanity
+- medical, legal or financial advice presented as certain
+- claims about real people that could be defamatory
+- spam, scams or engagement bait
+- content that makes no sense as a reply to the original post
+
+Respond with a JSON object only, in the form:
+{"flagged": false, "reason": "<a few words, empty if not flagged>"}`
+
+// ModerateReply asks the LLM whether a reply is safe to post
+func (c *OpenAIClient) ModerateReply(ctx context.Context, req ports.ModerationRequest) (*ports.ModerationResponse, error) {
+	var sb strings.Builder
+	if req.OriginalTweet.Text != "" {
+		sb.WriteString("## Original post\n")
+		sb.WriteString(fmt.Sprintf("@%s: %s\n\n", req.OriginalTweet.AuthorUsername, truncateText(req.OriginalTweet.Text, maxPromptTweetLength)))
+	}
+	sb.WriteString("## Reply\n")
+	sb.WriteString(req.Text)
+
+	text, tokensUsed, err := c.chat(ctx, ChatRequest{
+		Model:       c.config.Model,
+		MaxTokens:   100,
+		Temperature: 0.1,
+		Messages: []ChatMessage{
+			{Role: "system", Content: moderateSystemPrompt},
+			{Role: "user", Content: sb.String()},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := parseModeration(text)
+	if err != nil {
+		return nil, err
+	}
+	resp.TokensUsed = tokensUsed
+	return resp, nil
+}
+
+// parseModeration reads the JSON object of a moderation response, tolerating
+// code fences and text around it
+func parseModeration(text string) (*ports.ModerationResponse, error) {
+	start, end := strings.Index(text, "{"), strings.LastIndex(text, "}")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("moderation response has no JSON object")
+	}
+
+	var raw struct {
+		Flagged bool   `json:"flagged"`
+		Reason  string `json:"reason"`
+	}
+	if err := json.Unmarshal([]byte(text[start:end+1]), &raw); err != nil {
+		return nil, fmt.Errorf("invalid moderation response: %w", err)
+	}
+
+	return &ports.ModerationResponse{Flagged: raw.Flagged, Reason: strings.TrimSpace(raw.Reason)}, nil
+}
@@ -0,0 +1,41 @@
+package notification
+
+import (
+	"xtools/internal/domain"
+)
+
+// DefaultAlertPromptTemplate is the LLM prompt used to draft alert tweets
+const DefaultAlertPromptTemplate = `A brand-new Polymarket wallet just placed a large bet.
+{{with .Event}}{{if .EventTitle}}Market: {{.EventTitle}}
+{{else if .MarketName}}Market: {{.MarketName}}
+{{end}}{{if .Outcome}}Outcome: {{.Outcome}}
+{{end}}{{end}}Side: {{.Side}}
+Value: {{money .Notional}}
+{{with .Event}}{{if .WalletAddress}}Wallet: {{shortenAddr .WalletAddress}}
+{{end}}{{end}}{{with .Wallet}}Previous trades: {{.BetCount}}
+{{if .JoinDate}}Joined: {{.JoinDate}}
+{{end}}{{end}}{{with .Event}}Link: {{marketLink .}}{{end}}
+
+Write a tweet flagging this as unusual activity worth watching. State the facts, do not give financial advice, and include the link.`
+
+// RenderAlertPrompt renders an alert bridge prompt template for a trade.
+// An empty body uses DefaultAlertPromptTemplate.
+func RenderAlertPrompt(body string, event domain.PolymarketEvent) (string, error) {
+	if body == "" {
+		body = DefaultAlertPromptTemplate
+	}
+
+	tmpl, err := parseTemplate("alert_prompt", body, domain.NotificationFormatText)
+	if err != nil {
+		return "", err
+	}
+
+	return executeTemplate(tmpl, NewBigTradeTemplateData(event))
+}
+
+// ValidateAlertPrompt checks that an alert prompt template renders against sample data
+func ValidateAlertPrompt(body string) error {
+	sample := sampleTemplateData(domain.NotificationEventBigTrade)
+	_, err := RenderAlertPrompt(body, *sample.Event)
+	return err
+}
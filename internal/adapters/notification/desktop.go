@@ -0,0 +1,57 @@
+package notification
+
+import (
+	"context"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+// DesktopNotifier implements NotificationSender for native desktop notifications.
+// Notifications are emitted through the Wails event bus and shown by the
+// frontend using the OS notification center, so they only fire while the app is open.
+type DesktopNotifier struct {
+	eventBus ports.EventBus
+}
+
+// NewDesktopNotifier creates a new desktop notifier
+func NewDesktopNotifier(eventBus ports.EventBus) *DesktopNotifier {
+	return &DesktopNotifier{eventBus: eventBus}
+}
+
+// Send emits a desktop notification
+func (d *DesktopNotifier) Send(ctx context.Context, content domain.NotificationContent) error {
+	if !d.IsConfigured() {
+		return nil // Silently skip if not configured
+	}
+
+	d.eventBus.Emit(ports.EventDesktopNotification, ports.DesktopNotificationEvent{
+		EventType: string(content.EventType),
+		Title:     content.Title,
+		Body:      content.Message,
+		Link:      content.Link,
+		Priority:  content.Priority,
+	})
+	return nil
+}
+
+// SendTest emits a test desktop notification
+func (d *DesktopNotifier) SendTest(ctx context.Context) error {
+	if !d.IsConfigured() {
+		return &NotificationError{Message: "Desktop notifications are not available"}
+	}
+	return d.Send(ctx, domain.NewTestNotification())
+}
+
+// IsConfigured returns true if the notifier can deliver notifications
+func (d *DesktopNotifier) IsConfigured() bool {
+	return d.eventBus != nil
+}
+
+// GetChannel returns the notification channel type
+func (d *DesktopNotifier) GetChannel() domain.NotificationChannel {
+	return domain.NotificationChannelDesktop
+}
+
+// Ensure DesktopNotifier implements NotificationSender interface
+var _ ports.NotificationSender = (*DesktopNotifier)(nil)
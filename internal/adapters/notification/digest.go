@@ -0,0 +1,156 @@
+package notification
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"strconv"
+	"time"
+
+	"xtools/internal/domain"
+)
+
+// digestTrade is a top trade row in the digest email
+type digestTrade struct {
+	Market   string
+	Outcome  string
+	Side     string
+	Notional float64
+	Wallet   string
+	Fresh    bool
+	Link     string
+}
+
+// digestData is the data passed to the digest email template
+type digestData struct {
+	Since        time.Time
+	Until        time.Time
+	Trades       []digestTrade
+	FreshWallets []domain.WalletProfile
+	ReplyStats   []domain.AccountReplyStats
+	TopReplies   []domain.ReplyMetrics
+}
+
+var digestTemplate = htmltemplate.Must(htmltemplate.New("digest").Funcs(htmltemplate.FuncMap{
+	"money":          formatMoney,
+	"number":         formatNumber,
+	"shortenAddr":    shortenAddr,
+	"profileLink":    profileLink,
+	"freshnessEmoji": freshnessEmoji,
+	"tweetLink":      func(id string) string { return "https://x.com/i/web/status/" + id },
+	"date":           func(t time.Time) string { return t.Local().Format("Mon, Jan 2 2006 15:04") },
+}).Parse(`<!DOCTYPE html>
+<html>
+<body style="font-family:-apple-system,Segoe UI,Helvetica,Arial,sans-serif;font-size:14px;color:#111;max-width:720px">
+<h2 style="margin-bottom:4px">XTools Daily Digest</h2>
+<p style="color:#666;margin-top:0">{{date .Since}} &ndash; {{date .Until}}</p>
+
+<h3>Top Trades</h3>
+{{if .Trades}}
+<table cellpadding="6" cellspacing="0" style="border-collapse:collapse;width:100%">
+<tr style="background:#f3f4f6;text-align:left"><th>Market</th><th>Side</th><th style="text-align:right">Value</th><th>Wallet</th></tr>
+{{range .Trades}}
+<tr style="border-bottom:1px solid #e5e7eb">
+<td>{{if .Link}}<a href="{{.Link}}">{{.Market}}</a>{{else}}{{.Market}}{{end}}{{if .Outcome}} <span style="color:#666">({{.Outcome}})</span>{{end}}</td>
+<td>{{.Side}}</td>
+<td style="text-align:right">{{money .Notional}}</td>
+<td>{{if .Wallet}}<a href="{{profileLink .Wallet}}"><code>{{shortenAddr .Wallet}}</code></a>{{end}}{{if .Fresh}} 🚨{{end}}</td>
+</tr>
+{{end}}
+</table>
+{{else}}
+<p style="color:#666">No trades recorded.</p>
+{{end}}
+
+<h3>New Fresh Wallets</h3>
+{{if .FreshWallets}}
+<table cellpadding="6" cellspacing="0" style="border-collapse:collapse;width:100%">
+<tr style="background:#f3f4f6;text-align:left"><th>Wallet</th><th>Freshness</th><th style="text-align:right">Trades</th><th>Joined</th></tr>
+{{range .FreshWallets}}
+<tr style="border-bottom:1px solid #e5e7eb">
+<td><a href="{{profileLink .Address}}"><code>{{shortenAddr .Address}}</code></a></td>
+<td>{{freshnessEmoji .FreshnessLevel}} {{.FreshnessLevel}}</td>
+<td style="text-align:right">{{number .BetCount}}</td>
+<td>{{.JoinDate}}</td>
+</tr>
+{{end}}
+</table>
+{{else}}
+<p style="color:#666">No new fresh wallets.</p>
+{{end}}
+
+<h3>Replies by Account</h3>
+{{if .ReplyStats}}
+<table cellpadding="6" cellspacing="0" style="border-collapse:collapse;width:100%">
+<tr style="background:#f3f4f6;text-align:left"><th>Account</th><th style="text-align:right">Generated</th><th style="text-align:right">Posted</th><th style="text-align:right">Pending</th><th style="text-align:right">Rejected</th><th style="text-align:right">Failed</th></tr>
+{{range .ReplyStats}}
+<tr style="border-bottom:1px solid #e5e7eb">
+<td>{{if .Username}}@{{.Username}}{{else}}{{.AccountID}}{{end}}</td>
+<td style="text-align:right">{{number .Generated}}</td>
+<td style="text-align:right">{{number .Posted}}</td>
+<td style="text-align:right">{{number .Pending}}</td>
+<td style="text-align:right">{{number .Rejected}}</td>
+<td style="text-align:right">{{number .Failed}}</td>
+</tr>
+{{end}}
+</table>
+{{else}}
+<p style="color:#666">No reply activity.</p>
+{{end}}
+
+{{if .TopReplies}}
+<h3>Top Replies</h3>
+<table cellpadding="6" cellspacing="0" style="border-collapse:collapse;width:100%">
+<tr style="background:#f3f4f6;text-align:left"><th>Reply</th><th style="text-align:right">Likes</th><th style="text-align:right">Retweets</th><th style="text-align:right">Impressions</th></tr>
+{{range .TopReplies}}
+<tr style="border-bottom:1px solid #e5e7eb">
+<td>{{if .PostedReplyID}}<a href="{{tweetLink .PostedReplyID}}">{{if .Text}}{{.Text}}{{else}}{{.PostedReplyID}}{{end}}</a>{{else}}{{.Text}}{{end}}</td>
+<td style="text-align:right">{{number .LikeCount}}</td>
+<td style="text-align:right">{{number .RetweetCount}}</td>
+<td style="text-align:right">{{number .Impressions}}</td>
+</tr>
+{{end}}
+</table>
+{{end}}
+
+<p style="color:#888;font-size:12px;margin-top:24px">Sent by XTools. Disable the daily digest in Settings.</p>
+</body>
+</html>`))
+
+// RenderDigestEmail renders the subject and HTML body of a daily digest email
+func RenderDigestEmail(digest domain.NotificationDigest) (string, string, error) {
+	data := digestData{
+		Since:        digest.Since,
+		Until:        digest.Until,
+		FreshWallets: digest.FreshWallets,
+		ReplyStats:   digest.ReplyStats,
+		TopReplies:   digest.TopReplies,
+	}
+
+	for _, event := range digest.TopTrades {
+		td := NewBigTradeTemplateData(event)
+		market := event.EventTitle
+		if market == "" {
+			market = event.MarketName
+		}
+		data.Trades = append(data.Trades, digestTrade{
+			Market:   market,
+			Outcome:  event.Outcome,
+			Side:     td.Side,
+			Notional: td.Notional,
+			Wallet:   event.WalletAddress,
+			Fresh:    event.IsFreshWallet,
+			Link:     marketLink(event),
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := digestTemplate.Execute(&buf, data); err != nil {
+		return "", "", &NotificationError{Message: "Failed to render digest", Err: err}
+	}
+
+	subject := "[XTools] Daily Digest - " + digest.Until.Local().Format("Jan 2, 2006") +
+		" (" + strconv.Itoa(len(digest.TopTrades)) + " trades, " +
+		strconv.Itoa(len(digest.FreshWallets)) + " fresh wallets)"
+
+	return subject, buf.String(), nil
+}
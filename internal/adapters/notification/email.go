@@ -0,0 +1,248 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"html"
+	"log"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+const smtpDialTimeout = 15 * time.Second
+
+// EmailNotifier implements NotificationSender for email over SMTP
+type EmailNotifier struct {
+	mu     sync.RWMutex
+	config domain.SMTPConfig
+}
+
+// NewEmailNotifier creates a new email notifier
+func NewEmailNotifier(config domain.SMTPConfig) *EmailNotifier {
+	return &EmailNotifier{config: config}
+}
+
+// Send emails a single alert to all configured recipients
+func (e *EmailNotifier) Send(ctx context.Context, content domain.NotificationContent) error {
+	if !e.IsConfigured() {
+		return nil // Silently skip if not configured
+	}
+
+	return e.SendEmail(ctx, "[XTools] "+content.Title, wrapAlertHTML(content))
+}
+
+// SendTest sends a test email to verify configuration
+func (e *EmailNotifier) SendTest(ctx context.Context) error {
+	if !e.IsConfigured() {
+		return &NotificationError{Message: "Email is not configured. Please provide SMTP host, port, sender and at least one recipient."}
+	}
+
+	testContent := domain.NewTestNotification()
+	testContent.Message = html.EscapeString(testContent.Message)
+	return e.SendEmail(ctx, "[XTools] "+testContent.Title, wrapAlertHTML(testContent))
+}
+
+// IsConfigured returns true if the notifier is properly configured
+func (e *EmailNotifier) IsConfigured() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.config.IsConfigured()
+}
+
+// GetChannel returns the notification channel type
+func (e *EmailNotifier) GetChannel() domain.NotificationChannel {
+	return domain.NotificationChannelEmail
+}
+
+// UpdateConfig updates the notifier configuration
+func (e *EmailNotifier) UpdateConfig(config domain.SMTPConfig) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.config = config
+}
+
+// SendEmail sends an HTML email with the given subject to all recipients
+func (e *EmailNotifier) SendEmail(ctx context.Context, subject, htmlBody string) error {
+	e.mu.RLock()
+	config := e.config
+	e.mu.RUnlock()
+
+	if !config.IsConfigured() {
+		return &NotificationError{Message: "Email is not configured"}
+	}
+
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return &NotificationError{Message: "Invalid sender address", Err: err}
+	}
+
+	var recipients []string
+	for _, r := range config.Recipients {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+		addr, err := mail.ParseAddress(r)
+		if err != nil {
+			return &NotificationError{Message: fmt.Sprintf("Invalid recipient address %q", r), Err: err}
+		}
+		recipients = append(recipients, addr.Address)
+	}
+	if len(recipients) == 0 {
+		return &NotificationError{Message: "No email recipients configured"}
+	}
+
+	msg, err := buildEmailMessage(from, recipients, subject, htmlBody, time.Now())
+	if err != nil {
+		return &NotificationError{Message: "Failed to build email", Err: err}
+	}
+
+	if err := sendSMTP(ctx, config, from.Address, recipients, msg); err != nil {
+		return &NotificationError{Message: "Failed to send email", Err: err}
+	}
+
+	log.Printf("[EmailNotifier] Email sent to %d recipient(s)", len(recipients))
+	return nil
+}
+
+// sendSMTP delivers a message using the configured connection security and auth
+func sendSMTP(ctx context.Context, config domain.SMTPConfig, from string, to []string, msg []byte) error {
+	addr := net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
+	tlsConfig := &tls.Config{ServerName: config.Host}
+	dialer := &net.Dialer{Timeout: smtpDialTimeout}
+
+	var conn net.Conn
+	var err error
+	if config.Security == domain.SMTPSecurityTLS {
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: tlsConfig}
+		conn, err = tlsDialer.DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("connect to %s: %w", addr, err)
+	}
+
+	// Bound the whole SMTP conversation by the context deadline
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, config.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("smtp handshake: %w", err)
+	}
+	defer client.Close()
+
+	if config.Security == domain.SMTPSecuritySTARTTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return fmt.Errorf("server does not support STARTTLS")
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return fmt.Errorf("starttls: %w", err)
+		}
+	}
+
+	if config.Username != "" {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return fmt.Errorf("server does not support authentication")
+		}
+		// PlainAuth refuses to send credentials over unencrypted connections to remote hosts
+		auth := smtp.PlainAuth("", config.Username, config.Password, config.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("auth: %w", err)
+		}
+	}
+
+	if err := client.Mail(from); err != nil {
+		return fmt.Errorf("mail from: %w", err)
+	}
+	for _, rcpt := range to {
+		if err := client.Rcpt(rcpt); err != nil {
+			return fmt.Errorf("rcpt to %s: %w", rcpt, err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("data: %w", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		w.Close()
+		return fmt.Errorf("write message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("finish message: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// buildEmailMessage builds a MIME HTML message
+func buildEmailMessage(from *mail.Address, to []string, subject, htmlBody string, now time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+
+	headers := [][2]string{
+		{"From", from.String()},
+		{"To", strings.Join(to, ", ")},
+		{"Subject", mime.QEncoding.Encode("utf-8", subject)},
+		{"Date", now.Format(time.RFC1123Z)},
+		{"Message-ID", fmt.Sprintf("<%d.xtools@%s>", now.UnixNano(), senderDomain(from.Address))},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", `text/html; charset="UTF-8"`},
+		{"Content-Transfer-Encoding", "quoted-printable"},
+	}
+	for _, h := range headers {
+		fmt.Fprintf(&buf, "%s: %s\r\n", h[0], h[1])
+	}
+	buf.WriteString("\r\n")
+
+	qp := quotedprintable.NewWriter(&buf)
+	if _, err := qp.Write([]byte(htmlBody)); err != nil {
+		return nil, err
+	}
+	if err := qp.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// senderDomain returns the domain part of an email address
+func senderDomain(address string) string {
+	if i := strings.LastIndex(address, "@"); i >= 0 {
+		return address[i+1:]
+	}
+	return "localhost"
+}
+
+// wrapAlertHTML wraps a rendered alert message in a minimal HTML document
+func wrapAlertHTML(content domain.NotificationContent) string {
+	var b strings.Builder
+	b.WriteString(`<!DOCTYPE html><html><body style="font-family:-apple-system,Segoe UI,Helvetica,Arial,sans-serif;font-size:14px;color:#111">`)
+	b.WriteString(`<div style="white-space:pre-line">`)
+	b.WriteString(content.Message)
+	b.WriteString(`</div>`)
+	if content.Link != "" {
+		fmt.Fprintf(&b, `<p><a href="%s">Open on Polymarket</a></p>`, html.EscapeString(content.Link))
+	}
+	fmt.Fprintf(&b, `<p style="color:#888;font-size:12px">Sent by XTools at %s</p>`, html.EscapeString(content.Timestamp.Format(time.RFC1123)))
+	b.WriteString(`</body></html>`)
+	return b.String()
+}
+
+// Ensure EmailNotifier implements NotificationSender interface
+var _ ports.NotificationSender = (*EmailNotifier)(nil)
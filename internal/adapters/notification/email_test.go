@@ -0,0 +1,285 @@
+package notification
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime/quotedprintable"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"xtools/internal/domain"
+)
+
+// smtpStub is a minimal local SMTP server that records one message
+type smtpStub struct {
+	listener   net.Listener
+	extensions []string // EHLO extensions to advertise, e.g. "AUTH PLAIN"
+
+	mu   sync.Mutex
+	auth string // Decoded AUTH PLAIN credentials
+	from string
+	rcpt []string
+	data string
+}
+
+func newSMTPStub(t *testing.T, extensions ...string) *smtpStub {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := &smtpStub{listener: listener, extensions: extensions}
+	t.Cleanup(func() { listener.Close() })
+	go s.serve()
+	return s
+}
+
+func (s *smtpStub) config() domain.SMTPConfig {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return domain.SMTPConfig{
+		Host:       "127.0.0.1",
+		Port:       addr.Port,
+		Security:   domain.SMTPSecurityNone,
+		From:       "XTools <alerts@example.com>",
+		Recipients: []string{"ops@example.com", " Team <team@example.com> "},
+	}
+}
+
+func (s *smtpStub) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *smtpStub) handle(conn net.Conn) {
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(10 * time.Second))
+	r := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+
+	reply("220 localhost ESMTP stub")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+		switch verb {
+		case "EHLO", "HELO":
+			lines := append([]string{"localhost"}, s.extensions...)
+			for i, ext := range lines {
+				sep := "-"
+				if i == len(lines)-1 {
+					sep = " "
+				}
+				reply("250" + sep + ext)
+			}
+		case "AUTH":
+			fields := strings.Fields(line)
+			creds, _ := base64.StdEncoding.DecodeString(fields[len(fields)-1])
+			s.mu.Lock()
+			s.auth = string(creds)
+			s.mu.Unlock()
+			reply("235 2.7.0 Authentication successful")
+		case "MAIL":
+			s.mu.Lock()
+			s.from = line
+			s.mu.Unlock()
+			reply("250 OK")
+		case "RCPT":
+			s.mu.Lock()
+			s.rcpt = append(s.rcpt, line)
+			s.mu.Unlock()
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(l, "."))
+			}
+			s.mu.Lock()
+			s.data = data.String()
+			s.mu.Unlock()
+			reply("250 OK: queued")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+// body returns the decoded HTML body of the received message
+func (s *smtpStub) body(t *testing.T) (headers, body string) {
+	t.Helper()
+	s.mu.Lock()
+	data := s.data
+	s.mu.Unlock()
+
+	i := strings.Index(data, "\r\n\r\n")
+	if i < 0 {
+		t.Fatalf("message has no header/body separator: %q", data)
+	}
+	decoded, err := io.ReadAll(quotedprintable.NewReader(strings.NewReader(data[i+4:])))
+	if err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	return data[:i], string(decoded)
+}
+
+func TestEmailSendAlert(t *testing.T) {
+	stub := newSMTPStub(t, "AUTH PLAIN")
+	cfg := stub.config()
+	cfg.Username = "alerts@example.com"
+	cfg.Password = "app-password"
+	notifier := NewEmailNotifier(cfg)
+
+	err := notifier.Send(context.Background(), domain.NotificationContent{
+		Title:     "Big Trade Alert",
+		Message:   "<b>BUY</b> $25,000 on Will it rain?",
+		Link:      "https://polymarket.com/event/rain",
+		Timestamp: time.Date(2024, 10, 17, 18, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	if stub.auth != "\x00alerts@example.com\x00app-password" {
+		t.Errorf("AUTH PLAIN credentials = %q", stub.auth)
+	}
+	if stub.from != "MAIL FROM:<alerts@example.com>" {
+		t.Errorf("MAIL = %q", stub.from)
+	}
+	if want := []string{"RCPT TO:<ops@example.com>", "RCPT TO:<team@example.com>"}; strings.Join(stub.rcpt, ",") != strings.Join(want, ",") {
+		t.Errorf("RCPT = %v, want %v", stub.rcpt, want)
+	}
+
+	headers, body := stub.body(t)
+	if !strings.Contains(headers, "Subject: [XTools] Big Trade Alert\r\n") {
+		t.Errorf("headers missing subject:\n%s", headers)
+	}
+	if !strings.Contains(headers, "Content-Type: text/html") {
+		t.Errorf("headers missing HTML content type:\n%s", headers)
+	}
+	if !strings.Contains(body, "<b>BUY</b> $25,000 on Will it rain?") {
+		t.Errorf("body missing message:\n%s", body)
+	}
+	if !strings.Contains(body, `<a href="https://polymarket.com/event/rain">`) {
+		t.Errorf("body missing link:\n%s", body)
+	}
+}
+
+func TestEmailSendDigest(t *testing.T) {
+	stub := newSMTPStub(t)
+	notifier := NewEmailNotifier(stub.config())
+
+	until := time.Date(2024, 10, 17, 9, 0, 0, 0, time.Local)
+	subject, html, err := RenderDigestEmail(domain.NotificationDigest{
+		Since: until.Add(-24 * time.Hour),
+		Until: until,
+		TopTrades: []domain.PolymarketEvent{{
+			EventTitle:    "Will it rain?",
+			Outcome:       "Yes",
+			Side:          domain.OrderSideBuy,
+			Price:         "0.5",
+			Size:          "50000",
+			WalletAddress: "0x1234567890abcdef1234567890abcdef12345678",
+			IsFreshWallet: true,
+		}},
+		ReplyStats: []domain.AccountReplyStats{{AccountID: "acc1", Username: "golangdev", Generated: 12, Posted: 9}},
+	})
+	if err != nil {
+		t.Fatalf("RenderDigestEmail: %v", err)
+	}
+
+	if err := notifier.SendEmail(context.Background(), subject, html); err != nil {
+		t.Fatalf("SendEmail: %v", err)
+	}
+
+	headers, body := stub.body(t)
+	if !strings.Contains(headers, "Subject: [XTools] Daily Digest - Oct 17, 2024 (1 trades, 0 fresh wallets)") {
+		t.Errorf("headers missing digest subject:\n%s", headers)
+	}
+	for _, want := range []string{"XTools Daily Digest", "Will it rain?", "$25,000", "@golangdev", "No new fresh wallets."} {
+		if !strings.Contains(body, want) {
+			t.Errorf("digest body missing %q", want)
+		}
+	}
+}
+
+func TestEmailSendErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		extensions []string
+		configure  func(*domain.SMTPConfig)
+		wantErr    string
+	}{
+		{
+			name:      "STARTTLS unavailable",
+			configure: func(c *domain.SMTPConfig) { c.Security = domain.SMTPSecuritySTARTTLS },
+			wantErr:   "server does not support STARTTLS",
+		},
+		{
+			name:      "auth unavailable",
+			configure: func(c *domain.SMTPConfig) { c.Username, c.Password = "alerts@example.com", "secret" },
+			wantErr:   "server does not support authentication",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := newSMTPStub(t, tt.extensions...)
+			cfg := stub.config()
+			tt.configure(&cfg)
+
+			err := NewEmailNotifier(cfg).Send(context.Background(), domain.NewTestNotification())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want %q", err, tt.wantErr)
+			}
+			if stub.data != "" {
+				t.Error("message was sent despite the error")
+			}
+		})
+	}
+}
+
+func TestEmailSendUnreachable(t *testing.T) {
+	// Grab a free port, then close it so the dial is refused
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	notifier := NewEmailNotifier(domain.SMTPConfig{
+		Host:       "127.0.0.1",
+		Port:       port,
+		From:       "alerts@example.com",
+		Recipients: []string{"ops@example.com"},
+	})
+	err = notifier.SendEmail(context.Background(), "subject", "<p>body</p>")
+	if err == nil || !strings.Contains(err.Error(), "connect to 127.0.0.1:"+strconv.Itoa(port)) {
+		t.Errorf("err = %v, want a connect error", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/go-telegram/bot/models"
 
 	"xtools/internal/domain"
+	"xtools/internal/ports"
 )
 
 // TelegramNotifier implements NotificationSender for Telegram
@@ -116,6 +117,9 @@ func (t *TelegramNotifier) sendMessageToAll(ctx context.Context, text string) er
 	return nil
 }
 
+// Ensure TelegramNotifier implements NotificationSender interface
+var _ ports.NotificationSender = (*TelegramNotifier)(nil)
+
 // NotificationError represents a notification error
 type NotificationError struct {
 	Message string
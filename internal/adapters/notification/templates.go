@@ -0,0 +1,581 @@
+package notification
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"xtools/internal/domain"
+)
+
+// TemplateData is the data passed to notification templates
+type TemplateData struct {
+	EventType domain.NotificationEventType
+	Title     string
+	Timestamp time.Time
+
+	// Polymarket context (nil when not applicable)
+	Event  *domain.PolymarketEvent
+	Wallet *domain.WalletProfile
+
+	// Derived trade values
+	Side     string
+	Notional float64
+
+	// Twitter automation context
+	AccountID    string
+	Username     string
+	Status       string
+	Error        string
+	Failures     int
+	Pending      []AccountPendingCount
+	TotalPending int
+}
+
+// AccountPendingCount is the number of replies awaiting approval for an account
+type AccountPendingCount struct {
+	AccountID string
+	Username  string
+	Count     int
+}
+
+// NewBigTradeTemplateData builds template data for a big trade event
+func NewBigTradeTemplateData(event domain.PolymarketEvent) TemplateData {
+	side := "BUY"
+	if event.Side == domain.OrderSideSell {
+		side = "SELL"
+	}
+
+	price, _ := strconv.ParseFloat(event.Price, 64)
+	size, _ := strconv.ParseFloat(event.Size, 64)
+
+	return TemplateData{
+		EventType: domain.NotificationEventBigTrade,
+		Title:     "Big Trade Alert",
+		Timestamp: event.Timestamp,
+		Event:     &event,
+		Wallet:    event.WalletProfile,
+		Side:      side,
+		Notional:  price * size,
+	}
+}
+
+// NewFreshWalletTemplateData builds template data for a fresh wallet detection
+func NewFreshWalletTemplateData(profile domain.WalletProfile) TemplateData {
+	return TemplateData{
+		EventType: domain.NotificationEventFreshWallet,
+		Title:     "Fresh Wallet Detected",
+		Timestamp: profile.AnalyzedAt,
+		Wallet:    &profile,
+	}
+}
+
+// NewApprovalRequiredTemplateData builds template data for replies awaiting approval
+func NewApprovalRequiredTemplateData(pending []AccountPendingCount) TemplateData {
+	total := 0
+	for _, p := range pending {
+		total += p.Count
+	}
+	return TemplateData{
+		EventType:    domain.NotificationEventApprovalRequired,
+		Title:        "Replies Awaiting Approval",
+		Timestamp:    time.Now(),
+		Pending:      pending,
+		TotalPending: total,
+	}
+}
+
+// NewReplyFailedTemplateData builds template data for repeated reply failures
+func NewReplyFailedTemplateData(accountID, username string, failures int, lastError string) TemplateData {
+	return TemplateData{
+		EventType: domain.NotificationEventReplyFailed,
+		Title:     "Replies Failing",
+		Timestamp: time.Now(),
+		AccountID: accountID,
+		Username:  username,
+		Failures:  failures,
+		Error:     lastError,
+	}
+}
+
+// NewAccountErrorTemplateData builds template data for an account authentication problem
+func NewAccountErrorTemplateData(accountID, username, status, errMsg string) TemplateData {
+	title := "Account Authentication Failed"
+	if status == domain.AccountErrorCookiesExpired {
+		title = "Account Cookies Expired"
+	}
+	return TemplateData{
+		EventType: domain.NotificationEventAccountError,
+		Title:     title,
+		Timestamp: time.Now(),
+		AccountID: accountID,
+		Username:  username,
+		Status:    status,
+		Error:     errMsg,
+	}
+}
+
+// Default templates per event type and message format
+var defaultTemplates = map[domain.NotificationEventType]map[domain.NotificationFormat]string{
+	domain.NotificationEventBigTrade: {
+		domain.NotificationFormatHTML: `<b>{{sideEmoji .Side}} Big Trade Alert</b>
+
+{{with .Event}}{{if .EventTitle}}<b>Market:</b> {{esc .EventTitle}}
+{{end}}{{if .Outcome}}<b>Outcome:</b> {{esc .Outcome}}
+{{end}}{{end}}<b>Value:</b> {{money .Notional}}
+<b>Side:</b> {{.Side}}
+{{with .Event}}{{if .WalletAddress}}<b>Wallet:</b> <code>{{esc (shortenAddr .WalletAddress)}}</code>
+{{end}}{{end}}{{with .Wallet}}<b>Trades:</b> {{.BetCount}}
+{{if .JoinDate}}<b>Join Date:</b> {{esc .JoinDate}}
+{{end}}{{end}}{{with .Event}}{{if .WalletAddress}}
+<a href="{{profileLink .WalletAddress}}">View Profile</a>{{end}}{{end}}`,
+
+		domain.NotificationFormatMarkdown: `**{{sideEmoji .Side}} Big Trade Alert**
+
+{{with .Event}}{{if .EventTitle}}**Market:** {{esc .EventTitle}}
+{{end}}{{if .Outcome}}**Outcome:** {{esc .Outcome}}
+{{end}}{{end}}**Value:** {{money .Notional}}
+**Side:** {{.Side}}
+{{with .Event}}{{if .WalletAddress}}**Wallet:** ` + "`{{shortenAddr .WalletAddress}}`" + `
+{{end}}{{end}}{{with .Wallet}}**Trades:** {{.BetCount}}
+{{if .JoinDate}}**Join Date:** {{esc .JoinDate}}
+{{end}}{{end}}{{with .Event}}{{if .WalletAddress}}
+[View Profile]({{profileLink .WalletAddress}}){{end}}{{end}}`,
+
+		domain.NotificationFormatText: `{{sideEmoji .Side}} {{.Side}} {{money .Notional}}{{with .Event}}{{if .Outcome}} on {{.Outcome}}{{end}}{{if .EventTitle}}
+{{.EventTitle}}{{end}}{{if .WalletAddress}}
+Wallet {{shortenAddr .WalletAddress}}{{end}}{{end}}{{with .Wallet}} ({{.BetCount}} trades){{end}}`,
+
+		domain.NotificationFormatJSON: `{
+  "type": "{{.EventType}}",
+  "title": "{{esc .Title}}",
+  "timestamp": "{{formatTime .Timestamp}}",
+  "market": "{{with .Event}}{{esc .EventTitle}}{{end}}",
+  "outcome": "{{with .Event}}{{esc .Outcome}}{{end}}",
+  "side": "{{.Side}}",
+  "value": {{printf "%.2f" .Notional}},
+  "wallet": "{{with .Event}}{{esc .WalletAddress}}{{end}}",
+  "betCount": {{with .Wallet}}{{.BetCount}}{{else}}null{{end}},
+  "marketLink": "{{with .Event}}{{esc (marketLink .)}}{{end}}",
+  "profileLink": "{{with .Event}}{{if .WalletAddress}}{{esc (profileLink .WalletAddress)}}{{end}}{{end}}"
+}`,
+	},
+	domain.NotificationEventFreshWallet: {
+		domain.NotificationFormatHTML: `<b>{{freshnessEmoji .Wallet.FreshnessLevel}} Fresh Wallet Detected</b>
+
+{{with .Wallet}}{{if .Address}}<b>Wallet:</b> <code>{{esc (shortenAddr .Address)}}</code>
+{{end}}<b>Total Trades:</b> {{.BetCount}}
+{{if .JoinDate}}<b>Join Date:</b> {{esc .JoinDate}}
+{{end}}<b>Freshness:</b> {{esc (print .FreshnessLevel)}}
+{{if .Address}}
+<a href="{{profileLink .Address}}">View Profile</a>{{end}}{{end}}`,
+
+		domain.NotificationFormatMarkdown: `**{{freshnessEmoji .Wallet.FreshnessLevel}} Fresh Wallet Detected**
+
+{{with .Wallet}}{{if .Address}}**Wallet:** ` + "`{{shortenAddr .Address}}`" + `
+{{end}}**Total Trades:** {{.BetCount}}
+{{if .JoinDate}}**Join Date:** {{esc .JoinDate}}
+{{end}}**Freshness:** {{esc (print .FreshnessLevel)}}
+{{if .Address}}
+[View Profile]({{profileLink .Address}}){{end}}{{end}}`,
+
+		domain.NotificationFormatText: `{{freshnessEmoji .Wallet.FreshnessLevel}} {{shortenAddr .Wallet.Address}} ({{.Wallet.FreshnessLevel}})
+{{.Wallet.BetCount}} trades{{if .Wallet.JoinDate}}, joined {{.Wallet.JoinDate}}{{end}}`,
+
+		domain.NotificationFormatJSON: `{
+  "type": "{{.EventType}}",
+  "title": "{{esc .Title}}",
+  "timestamp": "{{formatTime .Timestamp}}",
+  "wallet": "{{esc .Wallet.Address}}",
+  "betCount": {{.Wallet.BetCount}},
+  "joinDate": "{{esc .Wallet.JoinDate}}",
+  "freshnessLevel": "{{.Wallet.FreshnessLevel}}",
+  "profileLink": "{{esc (profileLink .Wallet.Address)}}"
+}`,
+	},
+	domain.NotificationEventApprovalRequired: {
+		domain.NotificationFormatHTML: `<b>📝 {{.TotalPending}} {{if eq .TotalPending 1}}Reply{{else}}Replies{{end}} Awaiting Approval</b>
+{{range .Pending}}
+{{esc (accountName .AccountID .Username)}}: {{.Count}}{{end}}
+
+Open XTools to review the queue.`,
+
+		domain.NotificationFormatMarkdown: `**📝 {{.TotalPending}} {{if eq .TotalPending 1}}Reply{{else}}Replies{{end}} Awaiting Approval**
+{{range .Pending}}
+{{esc (accountName .AccountID .Username)}}: {{.Count}}{{end}}
+
+Open XTools to review the queue.`,
+
+		domain.NotificationFormatText: `{{.TotalPending}} {{if eq .TotalPending 1}}reply{{else}}replies{{end}} waiting: {{range $i, $p := .Pending}}{{if $i}}, {{end}}{{accountName $p.AccountID $p.Username}} ({{$p.Count}}){{end}}`,
+
+		domain.NotificationFormatJSON: `{
+  "type": "{{.EventType}}",
+  "title": "{{esc .Title}}",
+  "timestamp": "{{formatTime .Timestamp}}",
+  "totalPending": {{.TotalPending}},
+  "accounts": [{{range $i, $p := .Pending}}{{if $i}}, {{end}}{"accountId": "{{esc $p.AccountID}}", "username": "{{esc $p.Username}}", "pending": {{$p.Count}}}{{end}}]
+}`,
+	},
+	domain.NotificationEventReplyFailed: {
+		domain.NotificationFormatHTML: `<b>⚠️ Replies Failing</b>
+
+<b>Account:</b> {{esc (accountName .AccountID .Username)}}
+<b>Consecutive failures:</b> {{.Failures}}
+<b>Last error:</b> <code>{{esc .Error}}</code>`,
+
+		domain.NotificationFormatMarkdown: `**⚠️ Replies Failing**
+
+**Account:** {{esc (accountName .AccountID .Username)}}
+**Consecutive failures:** {{.Failures}}
+**Last error:** ` + "`{{.Error}}`",
+
+		domain.NotificationFormatText: `{{accountName .AccountID .Username}}: {{.Failures}} replies failed in a row
+{{.Error}}`,
+
+		domain.NotificationFormatJSON: `{
+  "type": "{{.EventType}}",
+  "title": "{{esc .Title}}",
+  "timestamp": "{{formatTime .Timestamp}}",
+  "accountId": "{{esc .AccountID}}",
+  "username": "{{esc .Username}}",
+  "failures": {{.Failures}},
+  "error": "{{esc .Error}}"
+}`,
+	},
+	domain.NotificationEventAccountError: {
+		domain.NotificationFormatHTML: `<b>🔑 {{esc .Title}}</b>
+
+<b>Account:</b> {{esc (accountName .AccountID .Username)}}
+{{if eq .Status "cookies_expired"}}Export fresh cookies from your browser and update the account.{{else}}<b>Error:</b> <code>{{esc .Error}}</code>{{end}}`,
+
+		domain.NotificationFormatMarkdown: `**🔑 {{esc .Title}}**
+
+**Account:** {{esc (accountName .AccountID .Username)}}
+{{if eq .Status "cookies_expired"}}Export fresh cookies from your browser and update the account.{{else}}**Error:** ` + "`{{.Error}}`" + `{{end}}`,
+
+		domain.NotificationFormatText: `{{accountName .AccountID .Username}}: {{if eq .Status "cookies_expired"}}cookies expired, update them to resume{{else}}{{.Error}}{{end}}`,
+
+		domain.NotificationFormatJSON: `{
+  "type": "{{.EventType}}",
+  "title": "{{esc .Title}}",
+  "timestamp": "{{formatTime .Timestamp}}",
+  "accountId": "{{esc .AccountID}}",
+  "username": "{{esc .Username}}",
+  "status": "{{esc .Status}}",
+  "error": "{{esc .Error}}"
+}`,
+	},
+}
+
+// DefaultTemplate returns the built-in template for an event type and channel
+func DefaultTemplate(eventType domain.NotificationEventType, channel domain.NotificationChannel) string {
+	if byFormat, ok := defaultTemplates[eventType]; ok {
+		return byFormat[channel.Format()]
+	}
+	return ""
+}
+
+// DefaultTemplates returns the built-in templates for all supported channels
+func DefaultTemplates() []domain.NotificationTemplate {
+	channels := []domain.NotificationChannel{
+		domain.NotificationChannelTelegram,
+		domain.NotificationChannelDiscord,
+		domain.NotificationChannelSlack,
+		domain.NotificationChannelWebhook,
+		domain.NotificationChannelDesktop,
+		domain.NotificationChannelEmail,
+	}
+	eventTypes := []domain.NotificationEventType{
+		domain.NotificationEventBigTrade,
+		domain.NotificationEventFreshWallet,
+		domain.NotificationEventApprovalRequired,
+		domain.NotificationEventReplyFailed,
+		domain.NotificationEventAccountError,
+	}
+
+	var templates []domain.NotificationTemplate
+	for _, eventType := range eventTypes {
+		for _, channel := range channels {
+			templates = append(templates, domain.NotificationTemplate{
+				EventType: eventType,
+				Channel:   channel,
+				Body:      DefaultTemplate(eventType, channel),
+			})
+		}
+	}
+	return templates
+}
+
+// TemplateRenderer renders notification messages from custom or default templates
+type TemplateRenderer struct {
+	mu        sync.RWMutex
+	custom    []domain.NotificationTemplate
+	templates map[string]*template.Template
+}
+
+// NewTemplateRenderer creates a renderer using the given custom templates
+func NewTemplateRenderer(custom []domain.NotificationTemplate) *TemplateRenderer {
+	r := &TemplateRenderer{}
+	r.SetTemplates(custom)
+	return r
+}
+
+// SetTemplates replaces the custom templates and clears the parse cache
+func (r *TemplateRenderer) SetTemplates(custom []domain.NotificationTemplate) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.custom = custom
+	r.templates = make(map[string]*template.Template)
+}
+
+// Render renders the message for an event type on a channel
+func (r *TemplateRenderer) Render(channel domain.NotificationChannel, data TemplateData) (string, error) {
+	key := string(data.EventType) + ":" + string(channel)
+
+	r.mu.RLock()
+	tmpl, ok := r.templates[key]
+	r.mu.RUnlock()
+
+	if !ok {
+		body := r.templateBody(data.EventType, channel)
+		if body == "" {
+			return "", &NotificationError{Message: fmt.Sprintf("no template for %s on %s", data.EventType, channel)}
+		}
+
+		var err error
+		tmpl, err = parseTemplate(key, body, channel.Format())
+		if err != nil {
+			return "", err
+		}
+
+		r.mu.Lock()
+		r.templates[key] = tmpl
+		r.mu.Unlock()
+	}
+
+	return executeTemplate(tmpl, data)
+}
+
+// templateBody returns the custom template if set, otherwise the default
+func (r *TemplateRenderer) templateBody(eventType domain.NotificationEventType, channel domain.NotificationChannel) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, t := range r.custom {
+		if t.EventType == eventType && t.Channel == channel && t.Body != "" {
+			return t.Body
+		}
+	}
+	return DefaultTemplate(eventType, channel)
+}
+
+// ValidateTemplate checks that a template parses and renders against sample data
+func ValidateTemplate(t domain.NotificationTemplate) error {
+	_, err := PreviewTemplate(t)
+	return err
+}
+
+// PreviewTemplate renders a template against sample data for the event type
+func PreviewTemplate(t domain.NotificationTemplate) (string, error) {
+	tmpl, err := parseTemplate(string(t.EventType), t.Body, t.Channel.Format())
+	if err != nil {
+		return "", err
+	}
+
+	out, err := executeTemplate(tmpl, sampleTemplateData(t.EventType))
+	if err != nil {
+		return "", err
+	}
+
+	if t.Channel.Format() == domain.NotificationFormatJSON && !json.Valid([]byte(out)) {
+		return out, &NotificationError{Message: "template does not render valid JSON"}
+	}
+
+	return out, nil
+}
+
+func parseTemplate(name, body string, format domain.NotificationFormat) (*template.Template, error) {
+	tmpl, err := template.New(name).Option("missingkey=zero").Funcs(templateFuncs(format)).Parse(body)
+	if err != nil {
+		return nil, &NotificationError{Message: "invalid template", Err: err}
+	}
+	return tmpl, nil
+}
+
+func executeTemplate(tmpl *template.Template, data TemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", &NotificationError{Message: "failed to render template", Err: err}
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// templateFuncs returns the helper functions available to templates.
+// "esc" escapes text for the channel's message format.
+func templateFuncs(format domain.NotificationFormat) template.FuncMap {
+	esc := func(s string) string { return s }
+	switch format {
+	case domain.NotificationFormatHTML:
+		esc = html.EscapeString
+	case domain.NotificationFormatMarkdown:
+		esc = escapeMarkdown
+	case domain.NotificationFormatJSON:
+		esc = escapeJSONString
+	}
+
+	return template.FuncMap{
+		"esc":            esc,
+		"shortenAddr":    shortenAddr,
+		"money":          formatMoney,
+		"number":         formatNumber,
+		"profileLink":    profileLink,
+		"marketLink":     marketLink,
+		"formatTime":     func(t time.Time) string { return t.UTC().Format(time.RFC3339) },
+		"upper":          strings.ToUpper,
+		"lower":          strings.ToLower,
+		"sideEmoji":      sideEmoji,
+		"freshnessEmoji": freshnessEmoji,
+		"accountName":    accountName,
+	}
+}
+
+// sampleTemplateData returns realistic data used for previews and validation
+func sampleTemplateData(eventType domain.NotificationEventType) TemplateData {
+	wallet := domain.WalletProfile{
+		Address:        "0x1234567890abcdef1234567890abcdef12345678",
+		BetCount:       2,
+		JoinDate:       "Dec 2025",
+		FreshnessLevel: domain.FreshnessInsider,
+		IsFresh:        true,
+		AnalyzedAt:     time.Now(),
+	}
+
+	switch eventType {
+	case domain.NotificationEventFreshWallet:
+		return NewFreshWalletTemplateData(wallet)
+	case domain.NotificationEventApprovalRequired:
+		return NewApprovalRequiredTemplateData([]AccountPendingCount{
+			{AccountID: "acc-1", Username: "alice", Count: 3},
+			{AccountID: "acc-2", Username: "bob", Count: 1},
+		})
+	case domain.NotificationEventReplyFailed:
+		return NewReplyFailedTemplateData("acc-1", "alice", 3, "rate limit exceeded")
+	case domain.NotificationEventAccountError:
+		return NewAccountErrorTemplateData("acc-1", "alice", domain.AccountErrorCookiesExpired, "browser cookies expired: redirected to login")
+	default:
+		data := NewBigTradeTemplateData(domain.PolymarketEvent{
+			EventType:     domain.PolymarketEventTrade,
+			MarketSlug:    "will-it-happen",
+			EventSlug:     "will-it-happen",
+			MarketName:    "Will it happen by Friday?",
+			EventTitle:    "Will it happen by Friday?",
+			MarketLink:    "https://polymarket.com/event/will-it-happen",
+			Timestamp:     time.Now(),
+			Price:         "0.42",
+			Size:          "25000",
+			Side:          domain.OrderSideBuy,
+			TradeID:       "0xabc",
+			WalletAddress: wallet.Address,
+			Outcome:       "Yes",
+			TraderName:    "sample-trader",
+			IsFreshWallet: true,
+			WalletProfile: &wallet,
+		})
+		data.EventType = eventType
+		return data
+	}
+}
+
+// Template helper functions
+
+func shortenAddr(addr string) string {
+	if len(addr) <= 10 {
+		return addr
+	}
+	return addr[:6] + "..." + addr[len(addr)-4:]
+}
+
+// accountName returns @username when known, otherwise the account ID
+func accountName(accountID, username string) string {
+	if username != "" {
+		return "@" + username
+	}
+	return accountID
+}
+
+func profileLink(addr string) string {
+	return "https://polymarket.com/profile/" + addr
+}
+
+func marketLink(event domain.PolymarketEvent) string {
+	if event.MarketLink != "" {
+		return event.MarketLink
+	}
+	if event.EventSlug != "" {
+		return "https://polymarket.com/event/" + event.EventSlug
+	}
+	if event.MarketSlug != "" {
+		return "https://polymarket.com/event/" + event.MarketSlug
+	}
+	return ""
+}
+
+// formatMoney formats a value as dollars with thousands separators (e.g. $12,345.67)
+func formatMoney(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 2, 64)
+	intPart, frac := s[:len(s)-3], s[len(s)-3:]
+	return "$" + groupThousands(intPart) + frac
+}
+
+// formatNumber formats an integer with thousands separators
+func formatNumber(v int) string {
+	return groupThousands(strconv.Itoa(v))
+}
+
+func groupThousands(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return sign + s
+}
+
+func sideEmoji(side string) string {
+	if side == "SELL" {
+		return "🔴"
+	}
+	return "🟢"
+}
+
+func freshnessEmoji(level domain.FreshnessLevel) string {
+	switch level {
+	case domain.FreshnessWallet:
+		return "🔥"
+	case domain.FreshnessNewbie:
+		return "⚡"
+	default:
+		return "🚨"
+	}
+}
+
+func escapeMarkdown(s string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "~", `\~`,
+		"[", `\[`, "]", `\]`, "|", `\|`, ">", `\>`,
+	)
+	return replacer.Replace(s)
+}
+
+func escapeJSONString(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b[1 : len(b)-1])
+}
@@ -0,0 +1,131 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+// discordMaxContent is the longest message Discord accepts in a webhook post
+const discordMaxContent = 2000
+
+// WebhookNotifier implements NotificationSender for Discord and Slack incoming
+// webhooks and for generic JSON webhooks. Messages arrive already rendered in
+// the channel's format: Markdown for Discord/Slack, a JSON document otherwise.
+type WebhookNotifier struct {
+	channel domain.NotificationChannel
+	client  *http.Client
+
+	mu  sync.RWMutex
+	url string
+}
+
+// NewWebhookNotifier creates a new webhook notifier for the given channel
+func NewWebhookNotifier(channel domain.NotificationChannel, url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		channel: channel,
+		client:  &http.Client{Timeout: 10 * time.Second},
+		url:     url,
+	}
+}
+
+// Send posts a notification to the webhook
+func (w *WebhookNotifier) Send(ctx context.Context, content domain.NotificationContent) error {
+	if !w.IsConfigured() {
+		return nil // Silently skip if not configured
+	}
+
+	return w.post(ctx, content.Message)
+}
+
+// SendTest posts a test notification to verify configuration
+func (w *WebhookNotifier) SendTest(ctx context.Context) error {
+	if !w.IsConfigured() {
+		return &NotificationError{Message: fmt.Sprintf("%s is not configured. Please provide a webhook URL.", w.channel)}
+	}
+
+	testContent := domain.NewTestNotification()
+	message := testContent.Message
+	if w.channel == domain.NotificationChannelWebhook {
+		body, err := json.Marshal(map[string]string{
+			"type":      string(testContent.EventType),
+			"title":     testContent.Title,
+			"message":   testContent.Message,
+			"timestamp": testContent.Timestamp.UTC().Format(time.RFC3339),
+		})
+		if err != nil {
+			return err
+		}
+		message = string(body)
+	}
+	return w.post(ctx, message)
+}
+
+// IsConfigured returns true if the notifier is properly configured
+func (w *WebhookNotifier) IsConfigured() bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.url != ""
+}
+
+// GetChannel returns the notification channel type
+func (w *WebhookNotifier) GetChannel() domain.NotificationChannel {
+	return w.channel
+}
+
+// UpdateConfig updates the webhook URL
+func (w *WebhookNotifier) UpdateConfig(url string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.url = url
+}
+
+// post wraps the message in the payload the channel expects and sends it
+func (w *WebhookNotifier) post(ctx context.Context, message string) error {
+	w.mu.RLock()
+	url := w.url
+	w.mu.RUnlock()
+
+	var body []byte
+	switch w.channel {
+	case domain.NotificationChannelDiscord:
+		if runes := []rune(message); len(runes) > discordMaxContent {
+			message = string(runes[:discordMaxContent-1]) + "…"
+		}
+		body, _ = json.Marshal(map[string]string{"content": message})
+	case domain.NotificationChannelSlack:
+		body, _ = json.Marshal(map[string]string{"text": message})
+	default:
+		body = []byte(message)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return &NotificationError{Message: fmt.Sprintf("invalid %s webhook URL", w.channel), Err: err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return &NotificationError{Message: fmt.Sprintf("failed to reach %s webhook", w.channel), Err: err}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return &NotificationError{Message: fmt.Sprintf("%s webhook returned %s: %s", w.channel, resp.Status, bytes.TrimSpace(detail))}
+	}
+
+	return nil
+}
+
+// Ensure WebhookNotifier implements NotificationSender interface
+var _ ports.NotificationSender = (*WebhookNotifier)(nil)
@@ -0,0 +1,106 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"xtools/internal/domain"
+)
+
+// webhookStub records the last JSON body posted to it
+func webhookStub(t *testing.T, status int) (*httptest.Server, *[]byte) {
+	t.Helper()
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("%s with Content-Type %q, want a JSON POST", r.Method, r.Header.Get("Content-Type"))
+		}
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+		io.WriteString(w, `{"message": "Invalid Webhook Token"}`)
+	}))
+	t.Cleanup(server.Close)
+	return server, &body
+}
+
+func TestWebhookSendPayloads(t *testing.T) {
+	message := "**BUY** $25,000 on _Will it rain?_"
+	tests := []struct {
+		channel domain.NotificationChannel
+		message string
+		field   string // Payload field holding the message, empty for the raw body
+	}{
+		{domain.NotificationChannelDiscord, message, "content"},
+		{domain.NotificationChannelSlack, message, "text"},
+		{domain.NotificationChannelWebhook, `{"type": "big_trade", "title": "Big Trade Alert"}`, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.channel), func(t *testing.T) {
+			server, body := webhookStub(t, http.StatusNoContent)
+			notifier := NewWebhookNotifier(tt.channel, server.URL)
+
+			if err := notifier.Send(context.Background(), domain.NotificationContent{Message: tt.message}); err != nil {
+				t.Fatalf("Send: %v", err)
+			}
+
+			if tt.field == "" {
+				if string(*body) != tt.message {
+					t.Errorf("body = %s, want the rendered JSON as-is", *body)
+				}
+				return
+			}
+			var payload map[string]string
+			if err := json.Unmarshal(*body, &payload); err != nil {
+				t.Fatalf("payload is not JSON: %s", *body)
+			}
+			if payload[tt.field] != tt.message {
+				t.Errorf("%s = %q, want %q", tt.field, payload[tt.field], tt.message)
+			}
+		})
+	}
+}
+
+func TestWebhookSendDiscordTruncates(t *testing.T) {
+	server, body := webhookStub(t, http.StatusNoContent)
+	notifier := NewWebhookNotifier(domain.NotificationChannelDiscord, server.URL)
+
+	if err := notifier.Send(context.Background(), domain.NotificationContent{Message: strings.Repeat("é", 2500)}); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	var payload map[string]string
+	json.Unmarshal(*body, &payload)
+	if n := len([]rune(payload["content"])); n != discordMaxContent {
+		t.Errorf("content is %d characters, want %d", n, discordMaxContent)
+	}
+}
+
+func TestWebhookSendTestIsValidJSON(t *testing.T) {
+	server, body := webhookStub(t, http.StatusOK)
+	notifier := NewWebhookNotifier(domain.NotificationChannelWebhook, server.URL)
+
+	if err := notifier.SendTest(context.Background()); err != nil {
+		t.Fatalf("SendTest: %v", err)
+	}
+	var payload map[string]string
+	if err := json.Unmarshal(*body, &payload); err != nil || payload["type"] != "test" {
+		t.Errorf("test payload = %s, want a JSON test event", *body)
+	}
+}
+
+func TestWebhookSendErrors(t *testing.T) {
+	server, _ := webhookStub(t, http.StatusUnauthorized)
+	err := NewWebhookNotifier(domain.NotificationChannelDiscord, server.URL).Send(context.Background(), domain.NotificationContent{Message: "hi"})
+	if err == nil || !strings.Contains(err.Error(), "401") || !strings.Contains(err.Error(), "Invalid Webhook Token") {
+		t.Errorf("err = %v, want the status and response body", err)
+	}
+
+	if err := NewWebhookNotifier(domain.NotificationChannelSlack, "").SendTest(context.Background()); err == nil {
+		t.Error("SendTest without a URL: want a not configured error")
+	}
+}
@@ -0,0 +1,173 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+// SQLiteKeywordStatsStore implements KeywordStatsStore using SQLite.
+// It shares the database with the reply and metrics stores, whose tables it joins.
+type SQLiteKeywordStatsStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteKeywordStatsStore creates a new keyword stats store
+func NewSQLiteKeywordStatsStore(db *sql.DB) (*SQLiteKeywordStatsStore, error) {
+	store := &SQLiteKeywordStatsStore{db: db}
+	if err := store.migrate(); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func (s *SQLiteKeywordStatsStore) migrate() error {
+	migrations := []string{
+		`CREATE TABLE IF NOT EXISTS keyword_stats (
+			account_id TEXT NOT NULL,
+			keyword TEXT NOT NULL,
+			date DATE NOT NULL,
+			tweets_found INTEGER NOT NULL DEFAULT 0,
+			PRIMARY KEY(account_id, keyword, date)
+		)`,
+		`CREATE TABLE IF NOT EXISTS reply_keywords (
+			reply_id TEXT NOT NULL,
+			account_id TEXT NOT NULL,
+			keyword TEXT NOT NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY(reply_id, keyword)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_reply_keywords_account ON reply_keywords(account_id, created_at)`,
+		// Created by the metrics store too; needed here for the engagement join
+		`CREATE TABLE IF NOT EXISTS reply_metrics (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			reply_id TEXT NOT NULL,
+			account_id TEXT NOT NULL,
+			original_tweet_id TEXT NOT NULL,
+			timestamp DATETIME NOT NULL,
+			like_count INTEGER,
+			retweet_count INTEGER,
+			impressions INTEGER
+		)`,
+	}
+
+	for _, m := range migrations {
+		if _, err := s.db.Exec(m); err != nil {
+			return fmt.Errorf("keyword stats store migration failed: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// RecordTweetsFound adds to today's found-tweet counts per keyword
+func (s *SQLiteKeywordStatsStore) RecordTweetsFound(accountID string, counts map[string]int) error {
+	date := time.Now().Format("2006-01-02")
+	for keyword, n := range counts {
+		_, err := s.db.Exec(`
+			INSERT INTO keyword_stats (account_id, keyword, date, tweets_found)
+			VALUES (?, ?, ?, ?)
+			ON CONFLICT(account_id, keyword, date) DO UPDATE SET tweets_found = tweets_found + excluded.tweets_found`,
+			accountID, keyword, date, n)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordReplyKeywords links a generated reply to the keywords that found its tweet
+func (s *SQLiteKeywordStatsStore) RecordReplyKeywords(accountID, replyID string, keywords []string) error {
+	for _, keyword := range keywords {
+		_, err := s.db.Exec(`
+			INSERT OR IGNORE INTO reply_keywords (reply_id, account_id, keyword, created_at)
+			VALUES (?, ?, ?, ?)`,
+			replyID, accountID, keyword, time.Now())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetKeywordStats returns per-keyword stats for the last days, best converting first
+func (s *SQLiteKeywordStatsStore) GetKeywordStats(accountID string, days int) ([]domain.KeywordStats, error) {
+	since := time.Now().AddDate(0, 0, -days)
+
+	// Engagement uses the latest metrics snapshot of each posted reply
+	rows, err := s.db.Query(`
+		WITH found AS (
+			SELECT keyword, SUM(tweets_found) AS tweets_found
+			FROM keyword_stats
+			WHERE account_id = ? AND date >= ?
+			GROUP BY keyword
+		),
+		latest AS (
+			SELECT m.reply_id, m.like_count, m.retweet_count, m.impressions
+			FROM reply_metrics m
+			WHERE m.account_id = ? AND m.timestamp = (
+				SELECT MAX(timestamp) FROM reply_metrics WHERE reply_id = m.reply_id
+			)
+		),
+		replied AS (
+			SELECT rk.keyword,
+				COUNT(*) AS generated,
+				SUM(CASE WHEN r.status = ? THEN 1 ELSE 0 END) AS posted,
+				COALESCE(SUM(l.like_count), 0) AS likes,
+				COALESCE(SUM(l.retweet_count), 0) AS retweets,
+				COALESCE(SUM(l.impressions), 0) AS impressions
+			FROM reply_keywords rk
+			LEFT JOIN replies r ON r.id = rk.reply_id
+			LEFT JOIN latest l ON l.reply_id = rk.reply_id
+			WHERE rk.account_id = ? AND rk.created_at >= ?
+			GROUP BY rk.keyword
+		),
+		keywords AS (
+			SELECT keyword FROM found UNION SELECT keyword FROM replied
+		)
+		SELECT k.keyword,
+			COALESCE(f.tweets_found, 0), COALESCE(rp.generated, 0), COALESCE(rp.posted, 0),
+			COALESCE(rp.likes, 0), COALESCE(rp.retweets, 0), COALESCE(rp.impressions, 0)
+		FROM keywords k
+		LEFT JOIN found f ON f.keyword = k.keyword
+		LEFT JOIN replied rp ON rp.keyword = k.keyword`,
+		accountID, since.Format("2006-01-02"),
+		accountID,
+		string(domain.ReplyStatusPosted), accountID, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []domain.KeywordStats
+	for rows.Next() {
+		var st domain.KeywordStats
+		if err := rows.Scan(&st.Keyword, &st.TweetsFound, &st.RepliesGenerated, &st.RepliesPosted,
+			&st.Likes, &st.Retweets, &st.Impressions); err != nil {
+			return nil, err
+		}
+		if st.TweetsFound > 0 {
+			st.ConversionRate = float64(st.RepliesPosted) / float64(st.TweetsFound)
+		}
+		stats = append(stats, st)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Best converting first, then most found
+	sort.SliceStable(stats, func(i, j int) bool {
+		if stats[i].ConversionRate != stats[j].ConversionRate {
+			return stats[i].ConversionRate > stats[j].ConversionRate
+		}
+		return stats[i].TweetsFound > stats[j].TweetsFound
+	})
+	return stats, nil
+}
+
+// Ensure SQLiteKeywordStatsStore implements KeywordStatsStore interface
+var _ ports.KeywordStatsStore = (*SQLiteKeywordStatsStore)(nil)
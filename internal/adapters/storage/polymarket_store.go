@@ -7,6 +7,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"xtools/internal/domain"
 )
@@ -262,6 +263,11 @@ func (s *PolymarketStore) GetEvents(filter domain.PolymarketEventFilter) ([]doma
 		args = append(args, filter.MaxWalletNonce)
 	}
 
+	if !filter.Since.IsZero() {
+		conditions = append(conditions, "timestamp >= ?")
+		args = append(args, filter.Since)
+	}
+
 	query := `SELECT id, event_type, asset_id, market_slug, market_name, market_image, market_link,
 		timestamp, raw_data, price, size, side, best_bid, best_ask, fee_rate_bps,
 		trade_id, wallet_address, outcome, outcome_index, event_slug, event_title,
@@ -273,7 +279,11 @@ func (s *PolymarketStore) GetEvents(filter domain.PolymarketEventFilter) ([]doma
 		query += " WHERE " + strings.Join(conditions, " AND ")
 	}
 
-	query += " ORDER BY timestamp DESC"
+	if filter.SortByValue {
+		query += " ORDER BY (CAST(price AS REAL) * CAST(size AS REAL)) DESC"
+	} else {
+		query += " ORDER BY timestamp DESC"
+	}
 
 	limit := filter.Limit
 	if limit <= 0 {
@@ -556,6 +566,26 @@ func (s *PolymarketStore) GetFreshWallets(limit int) ([]domain.WalletProfile, er
 	return s.scanWalletRows(rows)
 }
 
+// GetFreshWalletsSince retrieves fresh wallets first seen after the given time
+func (s *PolymarketStore) GetFreshWalletsSince(since time.Time, limit int) ([]domain.WalletProfile, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	rows, err := s.db.Query(`
+		SELECT address, bet_count, join_date, freshness_level, is_fresh, first_seen_at, last_analyzed_at
+		FROM polymarket_wallets
+		WHERE is_fresh = 1 AND first_seen_at >= ?
+		ORDER BY bet_count ASC, first_seen_at DESC
+		LIMIT ?`, since.UTC().Format("2006-01-02 15:04:05"), limit) // first_seen_at uses CURRENT_TIMESTAMP (UTC)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return s.scanWalletRows(rows)
+}
+
 // GetAllWallets retrieves all wallets from the database
 func (s *PolymarketStore) GetAllWallets(limit int) ([]domain.WalletProfile, error) {
 	if limit <= 0 {
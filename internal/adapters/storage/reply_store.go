@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"xtools/internal/domain"
@@ -48,6 +49,52 @@ func (s *SQLiteReplyStore) migrate() error {
 			error_message TEXT
 		)`,
 		`CREATE INDEX IF NOT EXISTS idx_replies_account ON replies(account_id)`,
+		`CREATE TABLE IF NOT EXISTS reply_candidates (
+			reply_id TEXT NOT NULL,
+			candidate_index INTEGER NOT NULL,
+			account_id TEXT NOT NULL,
+			tweet_id TEXT NOT NULL,
+			text TEXT NOT NULL,
+			style TEXT,
+			temperature REAL,
+			chosen INTEGER NOT NULL DEFAULT 0,
+			final_text TEXT,
+			decided_at DATETIME NOT NULL,
+			PRIMARY KEY (reply_id, candidate_index)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_reply_candidates_account ON reply_candidates(account_id, decided_at)`,
+		`CREATE TABLE IF NOT EXISTS tweets (
+			id TEXT PRIMARY KEY,
+			author_id TEXT,
+			author_username TEXT,
+			author_name TEXT,
+			author_bio TEXT,
+			author_followers INTEGER,
+			author_following INTEGER,
+			author_verified INTEGER,
+			text TEXT NOT NULL,
+			created_at DATETIME,
+			language TEXT,
+			like_count INTEGER,
+			retweet_count INTEGER,
+			reply_count INTEGER,
+			view_count INTEGER,
+			conversation_id TEXT,
+			in_reply_to_id TEXT,
+			discovered_at DATETIME NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_replies_tweet ON replies(tweet_id)`,
+		// Created by the metrics store too; needed here for the outcomes join
+		`CREATE TABLE IF NOT EXISTS reply_metrics (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			reply_id TEXT NOT NULL,
+			account_id TEXT NOT NULL,
+			original_tweet_id TEXT NOT NULL,
+			timestamp DATETIME NOT NULL,
+			like_count INTEGER,
+			retweet_count INTEGER,
+			impressions INTEGER
+		)`,
 	}
 
 	for _, m := range migrations {
@@ -56,6 +103,38 @@ func (s *SQLiteReplyStore) migrate() error {
 		}
 	}
 
+	// Add new columns (ignore errors if columns already exist)
+	newColumns := []string{
+		`ALTER TABLE replies ADD COLUMN reply_type TEXT DEFAULT ''`,
+		`ALTER TABLE replies ADD COLUMN media_json TEXT DEFAULT ''`,
+		`ALTER TABLE replies ADD COLUMN tweet_author TEXT DEFAULT ''`,
+		`ALTER TABLE replies ADD COLUMN scheduled_for DATETIME`,
+		`ALTER TABLE replies ADD COLUMN variant TEXT DEFAULT ''`,
+		`ALTER TABLE replies ADD COLUMN follow_up_depth INTEGER DEFAULT 0`,
+	}
+	for _, m := range newColumns {
+		s.db.Exec(m)
+	}
+	s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_replies_posted ON replies(posted_reply_id)`)
+
+	// Keep the tweets of replies queued before the tweets table existed
+	s.db.Exec(`
+		INSERT OR IGNORE INTO tweets
+		(id, author_id, author_username, author_name, author_bio, author_followers, author_following, author_verified,
+			text, created_at, language, like_count, retweet_count, reply_count, view_count, conversation_id, in_reply_to_id, discovered_at)
+		SELECT json_extract(original_tweet_json, '$.id'), json_extract(original_tweet_json, '$.authorId'),
+			json_extract(original_tweet_json, '$.authorUsername'), json_extract(original_tweet_json, '$.authorName'),
+			json_extract(original_tweet_json, '$.authorBio'), COALESCE(json_extract(original_tweet_json, '$.authorFollowers'), 0),
+			COALESCE(json_extract(original_tweet_json, '$.authorFollowing'), 0), COALESCE(json_extract(original_tweet_json, '$.authorVerified'), 0),
+			json_extract(original_tweet_json, '$.text'), json_extract(original_tweet_json, '$.createdAt'),
+			json_extract(original_tweet_json, '$.language'), json_extract(original_tweet_json, '$.likeCount'),
+			json_extract(original_tweet_json, '$.retweetCount'), json_extract(original_tweet_json, '$.replyCount'),
+			json_extract(original_tweet_json, '$.viewCount'), json_extract(original_tweet_json, '$.conversationId'),
+			json_extract(original_tweet_json, '$.inReplyToId'), queued_at
+		FROM pending_replies
+		WHERE COALESCE(json_extract(original_tweet_json, '$.id'), '') != ''
+			AND COALESCE(json_extract(reply_json, '$.type'), '') = ''`)
+
 	return nil
 }
 
@@ -168,26 +247,118 @@ func (s *SQLiteReplyStore) RemovePendingReply(replyID string) error {
 	return err
 }
 
+// ExpirePendingReplies moves queue items past their expiry to the reply history
+// with the expired status and returns them
+func (s *SQLiteReplyStore) ExpirePendingReplies(now time.Time) ([]domain.Reply, error) {
+	rows, err := s.db.Query(`SELECT id, reply_json FROM pending_replies WHERE expires_at <= ?`, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var expired []domain.Reply
+	for rows.Next() {
+		var id, replyJSON string
+		if err := rows.Scan(&id, &replyJSON); err != nil {
+			continue
+		}
+
+		var reply domain.Reply
+		if err := json.Unmarshal([]byte(replyJSON), &reply); err != nil {
+			continue
+		}
+		reply.ID = id
+		reply.Status = domain.ReplyStatusExpired
+		expired = append(expired, reply)
+	}
+	rows.Close()
+
+	for _, reply := range expired {
+		if err := s.SaveReply(reply); err != nil {
+			return nil, err
+		}
+		if err := s.RemovePendingReply(reply.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return expired, nil
+}
+
+// IsReplyQueued reports whether an account has a reply to the tweet waiting for
+// approval or in the posting queue
+func (s *SQLiteReplyStore) IsReplyQueued(accountID, tweetID string) (bool, error) {
+	var queued bool
+	err := s.db.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1 FROM pending_replies
+			WHERE account_id = ? AND json_extract(reply_json, '$.tweetId') = ?
+		) OR EXISTS (
+			SELECT 1 FROM replies
+			WHERE account_id = ? AND tweet_id = ? AND status IN (?, ?)
+		)`,
+		accountID, tweetID, accountID, tweetID,
+		string(domain.ReplyStatusScheduled), string(domain.ReplyStatusApproved)).Scan(&queued)
+	return queued, err
+}
+
+// UpdatePendingReply replaces the reply stored in the approval queue
+func (s *SQLiteReplyStore) UpdatePendingReply(reply domain.Reply) error {
+	replyJSON, err := json.Marshal(reply)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.db.Exec(`UPDATE pending_replies SET reply_json = ? WHERE id = ?`, string(replyJSON), reply.ID)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("pending reply not found")
+	}
+	return nil
+}
+
 // SaveReply saves a reply to the history
 func (s *SQLiteReplyStore) SaveReply(reply domain.Reply) error {
+	var mediaJSON string
+	if len(reply.Media) > 0 {
+		b, err := json.Marshal(reply.Media)
+		if err != nil {
+			return err
+		}
+		mediaJSON = string(b)
+	}
+
 	_, err := s.db.Exec(`
 		INSERT OR REPLACE INTO replies
-		(id, account_id, tweet_id, text, status, generated_at, posted_at, posted_reply_id, llm_tokens_used, error_message)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		(id, account_id, tweet_id, text, status, generated_at, posted_at, posted_reply_id, llm_tokens_used, error_message, reply_type, media_json, tweet_author, scheduled_for, variant, follow_up_depth)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 		reply.ID, reply.AccountID, reply.TweetID, reply.Text, string(reply.Status),
-		reply.GeneratedAt, reply.PostedAt, reply.PostedReplyID, reply.LLMTokensUsed, reply.ErrorMessage)
+		reply.GeneratedAt, reply.PostedAt, reply.PostedReplyID, reply.LLMTokensUsed, reply.ErrorMessage, string(reply.Type), mediaJSON, reply.TweetAuthor, reply.ScheduledFor, reply.Variant, reply.FollowUpDepth)
 	return err
 }
 
 // GetReplies returns replies for an account
 func (s *SQLiteReplyStore) GetReplies(accountID string, limit int) ([]domain.Reply, error) {
-	rows, err := s.db.Query(`
-		SELECT id, account_id, tweet_id, text, status, generated_at, posted_at, posted_reply_id, llm_tokens_used, error_message
+	return s.queryReplies(`
+		SELECT `+replyColumns+`
 		FROM replies
 		WHERE account_id = ?
 		ORDER BY generated_at DESC
 		LIMIT ?`,
 		accountID, limit)
+}
+
+// GetReplyHistory returns an account's latest replies with the tweets they replied to
+func (s *SQLiteReplyStore) GetReplyHistory(accountID string, limit int) ([]domain.Reply, error) {
+	rows, err := s.db.Query(`
+		SELECT `+replyColumns+`, `+tweetColumns+`
+		FROM replies
+		LEFT JOIN tweets t ON t.id = replies.tweet_id AND COALESCE(replies.reply_type, '') = ''
+		WHERE replies.account_id = ?
+		ORDER BY replies.generated_at DESC
+		LIMIT ?`,
+		accountID, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -195,54 +366,479 @@ func (s *SQLiteReplyStore) GetReplies(accountID string, limit int) ([]domain.Rep
 
 	var replies []domain.Reply
 	for rows.Next() {
-		var reply domain.Reply
+		var tweet joinedTweet
+		reply, err := scanReply(rows, tweet.dest()...)
+		if err != nil {
+			continue
+		}
+		reply.OriginalTweet = tweet.toDomain()
+		replies = append(replies, *reply)
+	}
+
+	return replies, nil
+}
+
+// replyColumns are the replies columns read by scanReply
+const replyColumns = `replies.id, replies.account_id, replies.tweet_id, replies.text, replies.status, replies.generated_at,
+		replies.posted_at, replies.posted_reply_id, replies.llm_tokens_used, replies.error_message,
+		COALESCE(replies.reply_type, ''), COALESCE(replies.media_json, ''), COALESCE(replies.tweet_author, ''),
+		replies.scheduled_for, COALESCE(replies.variant, ''), COALESCE(replies.follow_up_depth, 0)`
+
+// queryReplies runs a query selecting replyColumns and scans every row
+func (s *SQLiteReplyStore) queryReplies(query string, args ...interface{}) ([]domain.Reply, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var replies []domain.Reply
+	for rows.Next() {
+		reply, err := scanReply(rows)
+		if err != nil {
+			continue
+		}
+		replies = append(replies, *reply)
+	}
+
+	return replies, nil
+}
+
+// scanReply reads a row selected with replyColumns, followed by any extra columns
+func scanReply(row interface{ Scan(...interface{}) error }, extra ...interface{}) (*domain.Reply, error) {
+	var reply domain.Reply
+	var status, replyType, mediaJSON string
+	var postedAt, scheduledFor sql.NullTime
+
+	dest := []interface{}{&reply.ID, &reply.AccountID, &reply.TweetID, &reply.Text,
+		&status, &reply.GeneratedAt, &postedAt, &reply.PostedReplyID,
+		&reply.LLMTokensUsed, &reply.ErrorMessage, &replyType, &mediaJSON, &reply.TweetAuthor, &scheduledFor, &reply.Variant, &reply.FollowUpDepth}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	reply.Status = domain.ReplyStatus(status)
+	reply.Type = domain.ReplyType(replyType)
+	if mediaJSON != "" {
+		json.Unmarshal([]byte(mediaJSON), &reply.Media)
+	}
+	if postedAt.Valid {
+		reply.PostedAt = &postedAt.Time
+	}
+	if scheduledFor.Valid {
+		reply.ScheduledFor = &scheduledFor.Time
+	}
+
+	return &reply, nil
+}
+
+// GetReplyStats returns per-account reply counts for replies generated since the given time
+func (s *SQLiteReplyStore) GetReplyStats(since time.Time) ([]domain.AccountReplyStats, error) {
+	rows, err := s.db.Query(`
+		SELECT account_id, status, COUNT(*)
+		FROM replies
+		WHERE generated_at >= ?
+		GROUP BY account_id, status`,
+		since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	byAccount := make(map[string]*domain.AccountReplyStats)
+	var order []string
+	statsFor := func(accountID string) *domain.AccountReplyStats {
+		st, ok := byAccount[accountID]
+		if !ok {
+			st = &domain.AccountReplyStats{AccountID: accountID}
+			byAccount[accountID] = st
+			order = append(order, accountID)
+		}
+		return st
+	}
+
+	for rows.Next() {
+		var accountID, status string
+		var count int
+		if err := rows.Scan(&accountID, &status, &count); err != nil {
+			continue
+		}
+
+		st := statsFor(accountID)
+		st.Generated += count
+		switch domain.ReplyStatus(status) {
+		case domain.ReplyStatusPosted:
+			st.Posted += count
+		case domain.ReplyStatusFailed:
+			st.Failed += count
+		case domain.ReplyStatusRejected:
+			st.Rejected += count
+		}
+	}
+
+	// Replies still awaiting approval live in the pending queue
+	pendingRows, err := s.db.Query(`
+		SELECT account_id, COUNT(*)
+		FROM pending_replies
+		WHERE queued_at >= ?
+		GROUP BY account_id`,
+		since)
+	if err != nil {
+		return nil, err
+	}
+	defer pendingRows.Close()
+
+	for pendingRows.Next() {
+		var accountID string
+		var count int
+		if err := pendingRows.Scan(&accountID, &count); err != nil {
+			continue
+		}
+
+		st := statsFor(accountID)
+		st.Generated += count
+		st.Pending += count
+	}
+
+	stats := make([]domain.AccountReplyStats, 0, len(order))
+	for _, accountID := range order {
+		stats = append(stats, *byAccount[accountID])
+	}
+	return stats, nil
+}
+
+// CountRepliesSince counts an account's replies of a type generated since the given time,
+// including those still waiting in the approval queue
+func (s *SQLiteReplyStore) CountRepliesSince(accountID string, replyType domain.ReplyType, since time.Time) (int, error) {
+	var sent, pending int
+
+	err := s.db.QueryRow(`
+		SELECT COUNT(*) FROM replies
+		WHERE account_id = ? AND COALESCE(reply_type, '') = ? AND generated_at >= ?`,
+		accountID, string(replyType), since).Scan(&sent)
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.db.QueryRow(`
+		SELECT COUNT(*) FROM pending_replies
+		WHERE account_id = ? AND COALESCE(json_extract(reply_json, '$.type'), '') = ? AND queued_at >= ?`,
+		accountID, string(replyType), since).Scan(&pending)
+	if err != nil {
+		return 0, err
+	}
+
+	return sent + pending, nil
+}
+
+// CountPostedRepliesByAuthor counts an account's posted replies to each of the
+// given authors, keyed by lowercase username
+func (s *SQLiteReplyStore) CountPostedRepliesByAuthor(accountID string, authors []string) (map[string]int, error) {
+	counts := make(map[string]int)
+	if len(authors) == 0 {
+		return counts, nil
+	}
+
+	placeholders := make([]string, len(authors))
+	args := []interface{}{accountID, string(domain.ReplyStatusPosted)}
+	for i, author := range authors {
+		placeholders[i] = "?"
+		args = append(args, strings.ToLower(author))
+	}
+
+	rows, err := s.db.Query(`
+		SELECT LOWER(tweet_author), COUNT(*) FROM replies
+		WHERE account_id = ? AND status = ? AND LOWER(tweet_author) IN (`+strings.Join(placeholders, ", ")+`)
+		GROUP BY LOWER(tweet_author)`,
+		args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var author string
+		var n int
+		if err := rows.Scan(&author, &n); err != nil {
+			return nil, err
+		}
+		counts[author] = n
+	}
+	return counts, rows.Err()
+}
+
+// GetReplyOutcomes returns every experiment-tagged reply of an account with the
+// first engagement snapshot taken between minAge and maxAge after it posted, so
+// replies are compared at the same age. Replies still awaiting approval are included.
+func (s *SQLiteReplyStore) GetReplyOutcomes(accountID string, minAge, maxAge time.Duration) ([]domain.ReplyOutcome, error) {
+	rows, err := s.db.Query(`
+		SELECT r.id, r.variant, r.status, r.posted_at, m.timestamp, COALESCE(m.like_count, 0), COALESCE(m.impressions, 0)
+		FROM replies r
+		LEFT JOIN reply_metrics m ON m.reply_id = r.id
+		WHERE r.account_id = ? AND COALESCE(r.variant, '') != ''
+		ORDER BY r.id, m.timestamp ASC`,
+		accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var outcomes []domain.ReplyOutcome
+	index := make(map[string]int) // reply ID -> index in outcomes
+	for rows.Next() {
+		var o domain.ReplyOutcome
 		var status string
-		var postedAt sql.NullTime
+		var postedAt, snapshotAt sql.NullTime
+		if err := rows.Scan(&o.ReplyID, &o.Variant, &status, &postedAt, &snapshotAt, &o.LikeCount, &o.Impressions); err != nil {
+			continue
+		}
+		o.Status = domain.ReplyStatus(status)
 
-		if err := rows.Scan(&reply.ID, &reply.AccountID, &reply.TweetID, &reply.Text,
-			&status, &reply.GeneratedAt, &postedAt, &reply.PostedReplyID,
-			&reply.LLMTokensUsed, &reply.ErrorMessage); err != nil {
+		i, seen := index[o.ReplyID]
+		if !seen {
+			i = len(outcomes)
+			index[o.ReplyID] = i
+			outcomes = append(outcomes, domain.ReplyOutcome{ReplyID: o.ReplyID, Variant: o.Variant, Status: o.Status})
+		}
+		if outcomes[i].Measured || !postedAt.Valid || !snapshotAt.Valid {
 			continue
 		}
+		if age := snapshotAt.Time.Sub(postedAt.Time); age >= minAge && age < maxAge {
+			o.Measured = true
+			outcomes[i] = o
+		}
+	}
 
-		reply.Status = domain.ReplyStatus(status)
-		if postedAt.Valid {
-			reply.PostedAt = &postedAt.Time
+	pending, err := s.GetPendingReplies(accountID)
+	if err != nil {
+		return outcomes, nil
+	}
+	for _, item := range pending {
+		if item.Reply.Variant != "" {
+			outcomes = append(outcomes, domain.ReplyOutcome{
+				ReplyID: item.Reply.ID,
+				Variant: item.Reply.Variant,
+				Status:  domain.ReplyStatusPending,
+			})
 		}
+	}
+
+	return outcomes, nil
+}
+
+// SaveCandidateChoice records which of a reply's candidates the reviewer chose.
+// chosen is the candidate index, or -1 when every candidate was rejected; the
+// final text shows whether the chosen one was edited before posting.
+func (s *SQLiteReplyStore) SaveCandidateChoice(reply domain.Reply, chosen int) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
 
-		replies = append(replies, reply)
+	now := time.Now()
+	for i, c := range reply.Candidates {
+		finalText := ""
+		if i == chosen {
+			finalText = reply.Text
+		}
+		_, err := tx.Exec(`
+			INSERT OR REPLACE INTO reply_candidates
+				(reply_id, candidate_index, account_id, tweet_id, text, style, temperature, chosen, final_text, decided_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			reply.ID, i, reply.AccountID, reply.TweetID, c.Text, c.Style, c.Temperature, i == chosen, finalText, now)
+		if err != nil {
+			return err
+		}
 	}
 
-	return replies, nil
+	return tx.Commit()
+}
+
+// SaveTweets stores found or replied tweets with their author and the metrics
+// seen at discovery. Tweets already stored are left as first seen.
+func (s *SQLiteReplyStore) SaveTweets(tweets []domain.Tweet) error {
+	if len(tweets) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, t := range tweets {
+		if t.ID == "" {
+			continue
+		}
+		discoveredAt := t.DiscoveredAt
+		if discoveredAt.IsZero() {
+			discoveredAt = time.Now()
+		}
+		_, err := tx.Exec(`
+			INSERT OR IGNORE INTO tweets
+			(id, author_id, author_username, author_name, author_bio, author_followers, author_following, author_verified,
+				text, created_at, language, like_count, retweet_count, reply_count, view_count, conversation_id, in_reply_to_id, discovered_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			t.ID, t.AuthorID, t.AuthorUsername, t.AuthorName, t.AuthorBio, t.AuthorFollowers, t.AuthorFollowing, t.AuthorVerified,
+			t.Text, t.CreatedAt, t.Language, t.LikeCount, t.RetweetCount, t.ReplyCount, t.ViewCount, t.ConversationID, t.InReplyToID, discoveredAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetTweet returns a stored tweet
+func (s *SQLiteReplyStore) GetTweet(tweetID string) (*domain.Tweet, error) {
+	var tweet joinedTweet
+	err := s.db.QueryRow(`SELECT `+tweetColumns+` FROM tweets t WHERE t.id = ?`, tweetID).Scan(tweet.dest()...)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrTweetNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return tweet.toDomain(), nil
+}
+
+// tweetColumns are the columns of the tweets table aliased t, read into a joinedTweet
+const tweetColumns = `t.id, COALESCE(t.author_id, ''), COALESCE(t.author_username, ''), COALESCE(t.author_name, ''),
+		COALESCE(t.author_bio, ''), COALESCE(t.author_followers, 0), COALESCE(t.author_following, 0),
+		COALESCE(t.author_verified, 0), COALESCE(t.text, ''), t.created_at, COALESCE(t.language, ''),
+		COALESCE(t.like_count, 0), COALESCE(t.retweet_count, 0), COALESCE(t.reply_count, 0), COALESCE(t.view_count, 0),
+		COALESCE(t.conversation_id, ''), COALESCE(t.in_reply_to_id, ''), t.discovered_at`
+
+// joinedTweet receives tweetColumns; the ID is null when a left join found no tweet
+type joinedTweet struct {
+	id           sql.NullString
+	tweet        domain.Tweet
+	createdAt    sql.NullTime
+	discoveredAt sql.NullTime
+}
+
+func (j *joinedTweet) dest() []interface{} {
+	t := &j.tweet
+	return []interface{}{&j.id, &t.AuthorID, &t.AuthorUsername, &t.AuthorName,
+		&t.AuthorBio, &t.AuthorFollowers, &t.AuthorFollowing,
+		&t.AuthorVerified, &t.Text, &j.createdAt, &t.Language,
+		&t.LikeCount, &t.RetweetCount, &t.ReplyCount, &t.ViewCount,
+		&t.ConversationID, &t.InReplyToID, &j.discoveredAt}
+}
+
+// toDomain returns the scanned tweet, or nil if there was none
+func (j *joinedTweet) toDomain() *domain.Tweet {
+	if !j.id.Valid {
+		return nil
+	}
+	t := j.tweet
+	t.ID = j.id.String
+	t.CreatedAt = j.createdAt.Time
+	t.DiscoveredAt = j.discoveredAt.Time
+	return &t
 }
 
 // GetReplyByID returns a specific reply
 func (s *SQLiteReplyStore) GetReplyByID(replyID string) (*domain.Reply, error) {
-	var reply domain.Reply
-	var status string
-	var postedAt sql.NullTime
+	reply, err := scanReply(s.db.QueryRow(`SELECT `+replyColumns+` FROM replies WHERE id = ?`, replyID))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrAccountNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	err := s.db.QueryRow(`
-		SELECT id, account_id, tweet_id, text, status, generated_at, posted_at, posted_reply_id, llm_tokens_used, error_message
-		FROM replies
-		WHERE id = ?`,
-		replyID).Scan(&reply.ID, &reply.AccountID, &reply.TweetID, &reply.Text,
-		&status, &reply.GeneratedAt, &postedAt, &reply.PostedReplyID,
-		&reply.LLMTokensUsed, &reply.ErrorMessage)
+	return reply, nil
+}
 
+// GetReplyByPostedID returns the reply that was posted as the given tweet
+func (s *SQLiteReplyStore) GetReplyByPostedID(postedReplyID string) (*domain.Reply, error) {
+	if postedReplyID == "" {
+		return nil, domain.ErrTweetNotFound
+	}
+	reply, err := scanReply(s.db.QueryRow(`SELECT `+replyColumns+` FROM replies WHERE posted_reply_id = ?`, postedReplyID))
 	if err == sql.ErrNoRows {
-		return nil, domain.ErrAccountNotFound
+		return nil, domain.ErrTweetNotFound
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	reply.Status = domain.ReplyStatus(status)
-	if postedAt.Valid {
-		reply.PostedAt = &postedAt.Time
+	return reply, nil
+}
+
+// GetPostedRepliesSince returns replies posted since the given time that have a
+// posted tweet ID, oldest first
+func (s *SQLiteReplyStore) GetPostedRepliesSince(since time.Time) ([]domain.Reply, error) {
+	return s.queryReplies(`
+		SELECT `+replyColumns+`
+		FROM replies
+		WHERE status = ? AND posted_reply_id != '' AND posted_at >= ?
+		ORDER BY posted_at ASC`,
+		string(domain.ReplyStatusPosted), since)
+}
+
+// GetDueReplies returns scheduled replies whose posting time has passed, oldest first
+func (s *SQLiteReplyStore) GetDueReplies(now time.Time) ([]domain.Reply, error) {
+	return s.queryReplies(`
+		SELECT `+replyColumns+`
+		FROM replies
+		WHERE status = ? AND scheduled_for <= ?
+		ORDER BY scheduled_for ASC`,
+		string(domain.ReplyStatusScheduled), now)
+}
+
+// ClaimScheduledReply moves a scheduled reply to approved so only one caller posts it
+func (s *SQLiteReplyStore) ClaimScheduledReply(replyID string) (bool, error) {
+	res, err := s.db.Exec(`UPDATE replies SET status = ? WHERE id = ? AND status = ?`,
+		string(domain.ReplyStatusApproved), replyID, string(domain.ReplyStatusScheduled))
+	if err != nil {
+		return false, err
 	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
 
-	return &reply, nil
+// FailInterruptedReplies marks replies left in the approved state (claimed for
+// posting when a previous run stopped) as failed, so they are not posted twice
+func (s *SQLiteReplyStore) FailInterruptedReplies() (int, error) {
+	res, err := s.db.Exec(`UPDATE replies SET status = ?, error_message = ? WHERE status = ?`,
+		string(domain.ReplyStatusFailed), "interrupted while posting; check the account before retrying",
+		string(domain.ReplyStatusApproved))
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+// LastReplySlot returns the latest time an account's reply was posted or is
+// scheduled to post; zero if there is none
+func (s *SQLiteReplyStore) LastReplySlot(accountID string) (time.Time, error) {
+	var scheduledFor, postedAt sql.NullTime
+	err := s.db.QueryRow(`
+		SELECT scheduled_for, posted_at
+		FROM replies
+		WHERE account_id = ? AND status IN (?, ?) AND COALESCE(reply_type, '') = ''
+		ORDER BY COALESCE(scheduled_for, posted_at) DESC
+		LIMIT 1`,
+		accountID, string(domain.ReplyStatusScheduled), string(domain.ReplyStatusPosted)).Scan(&scheduledFor, &postedAt)
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	last := scheduledFor.Time
+	if postedAt.Valid && postedAt.Time.After(last) {
+		last = postedAt.Time
+	}
+	return last, nil
 }
 
 // Ensure SQLiteReplyStore implements ReplyStore interface
@@ -0,0 +1,209 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+// SQLiteScheduledPostStore implements ScheduledPostStore using SQLite
+type SQLiteScheduledPostStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteScheduledPostStore creates a new scheduled post store
+func NewSQLiteScheduledPostStore(db *sql.DB) (*SQLiteScheduledPostStore, error) {
+	store := &SQLiteScheduledPostStore{db: db}
+	if err := store.migrate(); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func (s *SQLiteScheduledPostStore) migrate() error {
+	migrations := []string{
+		`CREATE TABLE IF NOT EXISTS scheduled_posts (
+			id TEXT PRIMARY KEY,
+			account_id TEXT NOT NULL,
+			post_type TEXT NOT NULL,
+			texts_json TEXT NOT NULL,
+			quote_tweet_id TEXT,
+			scheduled_at DATETIME NOT NULL,
+			status TEXT NOT NULL,
+			created_at DATETIME NOT NULL,
+			posted_at DATETIME,
+			posted_ids_json TEXT,
+			error_message TEXT
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_scheduled_posts_account ON scheduled_posts(account_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_scheduled_posts_due ON scheduled_posts(status, scheduled_at)`,
+	}
+
+	for _, m := range migrations {
+		if _, err := s.db.Exec(m); err != nil {
+			return fmt.Errorf("scheduled post store migration failed: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// SavePost inserts or updates a scheduled post
+func (s *SQLiteScheduledPostStore) SavePost(post domain.ScheduledPost) error {
+	textsJSON, err := json.Marshal(post.Texts)
+	if err != nil {
+		return err
+	}
+
+	postedIDsJSON, err := json.Marshal(post.PostedIDs)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(`
+		INSERT OR REPLACE INTO scheduled_posts
+		(id, account_id, post_type, texts_json, quote_tweet_id, scheduled_at, status, created_at, posted_at, posted_ids_json, error_message)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		post.ID, post.AccountID, string(post.Type), string(textsJSON), post.QuoteTweetID,
+		post.ScheduledAt.UTC(), string(post.Status), post.CreatedAt, post.PostedAt, string(postedIDsJSON), post.ErrorMessage)
+	return err
+}
+
+// GetPost returns a scheduled post by ID
+func (s *SQLiteScheduledPostStore) GetPost(postID string) (*domain.ScheduledPost, error) {
+	row := s.db.QueryRow(`
+		SELECT id, account_id, post_type, texts_json, quote_tweet_id, scheduled_at, status, created_at, posted_at, posted_ids_json, error_message
+		FROM scheduled_posts WHERE id = ?`, postID)
+
+	post, err := scanScheduledPost(row)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("scheduled post not found")
+	}
+	return post, err
+}
+
+// GetPosts returns scheduled posts for an account, newest schedule first.
+// An empty account ID returns posts for all accounts.
+func (s *SQLiteScheduledPostStore) GetPosts(accountID string, limit int) ([]domain.ScheduledPost, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	rows, err := s.db.Query(`
+		SELECT id, account_id, post_type, texts_json, quote_tweet_id, scheduled_at, status, created_at, posted_at, posted_ids_json, error_message
+		FROM scheduled_posts
+		WHERE ? = '' OR account_id = ?
+		ORDER BY scheduled_at DESC
+		LIMIT ?`,
+		accountID, accountID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanScheduledPosts(rows)
+}
+
+// GetDuePosts returns scheduled posts whose time has come, oldest first.
+// Schedule times are stored in UTC so they compare correctly as text.
+func (s *SQLiteScheduledPostStore) GetDuePosts(now time.Time) ([]domain.ScheduledPost, error) {
+	rows, err := s.db.Query(`
+		SELECT id, account_id, post_type, texts_json, quote_tweet_id, scheduled_at, status, created_at, posted_at, posted_ids_json, error_message
+		FROM scheduled_posts
+		WHERE status = ? AND scheduled_at <= ?
+		ORDER BY scheduled_at ASC`,
+		string(domain.ScheduledPostStatusScheduled), now.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanScheduledPosts(rows)
+}
+
+// ClaimPost marks a scheduled post as posting. It returns false if the post
+// is no longer scheduled (cancelled, or claimed elsewhere).
+func (s *SQLiteScheduledPostStore) ClaimPost(postID string) (bool, error) {
+	res, err := s.db.Exec(`UPDATE scheduled_posts SET status = ? WHERE id = ? AND status = ?`,
+		string(domain.ScheduledPostStatusPosting), postID, string(domain.ScheduledPostStatusScheduled))
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// FailInterruptedPosts marks posts left in the posting state (e.g. by a crash) as failed,
+// so they are not published twice
+func (s *SQLiteScheduledPostStore) FailInterruptedPosts() (int, error) {
+	res, err := s.db.Exec(`UPDATE scheduled_posts SET status = ?, error_message = ? WHERE status = ?`,
+		string(domain.ScheduledPostStatusFailed), "interrupted while posting; check the account before retrying",
+		string(domain.ScheduledPostStatusPosting))
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+// DeletePost removes a scheduled post
+func (s *SQLiteScheduledPostStore) DeletePost(postID string) error {
+	_, err := s.db.Exec(`DELETE FROM scheduled_posts WHERE id = ?`, postID)
+	return err
+}
+
+type scheduledPostScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanScheduledPost(row scheduledPostScanner) (*domain.ScheduledPost, error) {
+	var post domain.ScheduledPost
+	var postType, status, textsJSON string
+	var quoteTweetID, postedIDsJSON, errorMessage sql.NullString
+	var postedAt sql.NullTime
+
+	err := row.Scan(&post.ID, &post.AccountID, &postType, &textsJSON, &quoteTweetID,
+		&post.ScheduledAt, &status, &post.CreatedAt, &postedAt, &postedIDsJSON, &errorMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	post.Type = domain.ScheduledPostType(postType)
+	post.Status = domain.ScheduledPostStatus(status)
+	post.QuoteTweetID = quoteTweetID.String
+	post.ErrorMessage = errorMessage.String
+	if postedAt.Valid {
+		post.PostedAt = &postedAt.Time
+	}
+
+	json.Unmarshal([]byte(textsJSON), &post.Texts)
+	if postedIDsJSON.Valid {
+		json.Unmarshal([]byte(postedIDsJSON.String), &post.PostedIDs)
+	}
+
+	return &post, nil
+}
+
+func scanScheduledPosts(rows *sql.Rows) ([]domain.ScheduledPost, error) {
+	var posts []domain.ScheduledPost
+	for rows.Next() {
+		post, err := scanScheduledPost(rows)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, *post)
+	}
+	return posts, rows.Err()
+}
+
+// Ensure SQLiteScheduledPostStore implements ScheduledPostStore interface
+var _ ports.ScheduledPostStore = (*SQLiteScheduledPostStore)(nil)
@@ -69,6 +69,7 @@ func (s *SQLiteMetricsStore) migrate() error {
 			impressions INTEGER
 		)`,
 		`CREATE INDEX IF NOT EXISTS idx_reply_metrics ON reply_metrics(account_id, timestamp)`,
+		`CREATE INDEX IF NOT EXISTS idx_reply_metrics_reply ON reply_metrics(reply_id, timestamp)`,
 		`CREATE TABLE IF NOT EXISTS daily_stats (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
 			account_id TEXT NOT NULL,
@@ -89,6 +90,13 @@ func (s *SQLiteMetricsStore) migrate() error {
 			UNIQUE(account_id, tweet_id)
 		)`,
 		`CREATE INDEX IF NOT EXISTS idx_replied ON replied_tweets(account_id, tweet_id)`,
+		`CREATE TABLE IF NOT EXISTS search_cursors (
+			account_id TEXT NOT NULL,
+			query_key TEXT NOT NULL,
+			since_id TEXT NOT NULL,
+			updated_at DATETIME NOT NULL,
+			PRIMARY KEY(account_id, query_key)
+		)`,
 	}
 
 	for _, m := range migrations {
@@ -186,6 +194,9 @@ func (s *SQLiteMetricsStore) SaveReplyMetrics(metrics domain.ReplyMetrics) error
 	return err
 }
 
+// latestReplyMetrics selects the most recent snapshot of each reply
+const latestReplyMetrics = `id IN (SELECT MAX(id) FROM reply_metrics GROUP BY reply_id)`
+
 // GetReplyPerformance returns reply performance report
 func (s *SQLiteMetricsStore) GetReplyPerformance(accountID string, days int) (*domain.ReplyPerformanceReport, error) {
 	since := time.Now().AddDate(0, 0, -days)
@@ -194,38 +205,66 @@ func (s *SQLiteMetricsStore) GetReplyPerformance(accountID string, days int) (*d
 	report.AccountID = accountID
 	report.Period = fmt.Sprintf("%d days", days)
 
-	// Get aggregate stats
+	// Get aggregate stats from each reply's latest snapshot
 	row := s.db.QueryRow(`
 		SELECT COUNT(*), COALESCE(AVG(like_count), 0), COALESCE(AVG(impressions), 0)
 		FROM reply_metrics
-		WHERE account_id = ? AND timestamp >= ?`,
+		WHERE account_id = ? AND timestamp >= ? AND `+latestReplyMetrics,
 		accountID, since)
 
 	row.Scan(&report.TotalReplies, &report.AvgLikesPerReply, &report.AvgImpressionsPerReply)
 
 	// Get top performing replies
+	top, err := s.GetTopReplies(accountID, since, 5)
+	if err != nil {
+		return &report, nil
+	}
+	report.TopPerformingReplies = top
+
+	return &report, nil
+}
+
+// GetTopReplies returns the replies with the most likes by their latest snapshot
+// taken since the given time. An empty account ID covers all accounts.
+func (s *SQLiteMetricsStore) GetTopReplies(accountID string, since time.Time, limit int) ([]domain.ReplyMetrics, error) {
 	rows, err := s.db.Query(`
 		SELECT reply_id, account_id, original_tweet_id, timestamp, like_count, retweet_count, impressions
 		FROM reply_metrics
-		WHERE account_id = ? AND timestamp >= ?
-		ORDER BY like_count DESC
-		LIMIT 5`,
-		accountID, since)
+		WHERE (? = '' OR account_id = ?) AND timestamp >= ? AND `+latestReplyMetrics+`
+		ORDER BY like_count DESC, retweet_count DESC, impressions DESC
+		LIMIT ?`,
+		accountID, accountID, since, limit)
 	if err != nil {
-		return &report, nil
+		return nil, err
 	}
 	defer rows.Close()
 
+	var top []domain.ReplyMetrics
 	for rows.Next() {
 		var m domain.ReplyMetrics
 		if err := rows.Scan(&m.ReplyID, &m.AccountID, &m.OriginalTweetID, &m.Timestamp,
 			&m.LikeCount, &m.RetweetCount, &m.Impressions); err != nil {
 			continue
 		}
-		report.TopPerformingReplies = append(report.TopPerformingReplies, m)
+		top = append(top, m)
 	}
 
-	return &report, nil
+	return top, nil
+}
+
+// LastReplyMetricsAt returns when a reply's metrics were last recorded; zero if never
+func (s *SQLiteMetricsStore) LastReplyMetricsAt(replyID string) (time.Time, error) {
+	var last sql.NullTime
+	err := s.db.QueryRow(`
+		SELECT timestamp FROM reply_metrics
+		WHERE reply_id = ?
+		ORDER BY timestamp DESC
+		LIMIT 1`,
+		replyID).Scan(&last)
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil
+	}
+	return last.Time, err
 }
 
 // SaveDailyStats saves daily statistics
@@ -291,6 +330,27 @@ func (s *SQLiteMetricsStore) MarkReplied(accountID, tweetID, replyID string) err
 	return err
 }
 
+// GetSearchCursor returns the newest tweet ID seen for a query, or "" if none
+func (s *SQLiteMetricsStore) GetSearchCursor(accountID, queryKey string) (string, error) {
+	var sinceID string
+	err := s.db.QueryRow(`
+		SELECT since_id FROM search_cursors WHERE account_id = ? AND query_key = ?`,
+		accountID, queryKey).Scan(&sinceID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return sinceID, err
+}
+
+// SaveSearchCursor stores the newest tweet ID seen for a query
+func (s *SQLiteMetricsStore) SaveSearchCursor(accountID, queryKey, sinceID string) error {
+	_, err := s.db.Exec(`
+		INSERT OR REPLACE INTO search_cursors (account_id, query_key, since_id, updated_at)
+		VALUES (?, ?, ?, ?)`,
+		accountID, queryKey, sinceID, time.Now())
+	return err
+}
+
 // Close closes the database connection
 func (s *SQLiteMetricsStore) Close() error {
 	return s.db.Close()
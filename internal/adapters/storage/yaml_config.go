@@ -220,6 +220,7 @@ func (s *YAMLConfigStore) CreateExampleConfig() error {
 			MinRetweets:     10,
 			MaxAgeMins:      60,
 			IntervalSecs:    300,
+			MaxPages:        1,
 		},
 		ReplyConfig: domain.ReplyConfig{
 			ApprovalMode:   domain.ApprovalModeQueue,
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -39,6 +40,10 @@ type APIClient struct {
 	rateLimitMu  sync.RWMutex
 	rateLimit    *domain.RateLimitStatus
 	authenticated bool
+	apiURL       string // API v2 base, replaceable for tests
+	userMu       sync.Mutex
+	userID       string // Authenticated user, looked up on first use
+	mediaURL     string // Media upload API base, replaceable for tests
 }
 
 // NewAPIClient creates a new Twitter API v2 client
@@ -56,6 +61,8 @@ func NewAPIClient(creds domain.APICredentials) (*APIClient, error) {
 			Timeout: 30 * time.Second,
 		},
 		rateLimit: &domain.RateLimitStatus{},
+		apiURL:    baseURL,
+		mediaURL:  mediaBaseURL,
 	}, nil
 }
 
@@ -87,14 +94,26 @@ func (c *APIClient) SearchTweets(ctx context.Context, query string, opts ports.S
 	params.Set("query", c.buildSearchQuery(query, opts))
 	params.Set("max_results", fmt.Sprintf("%d", min(opts.MaxResults, 100)))
 	params.Set("tweet.fields", "id,text,author_id,created_at,conversation_id,public_metrics,lang,referenced_tweets")
-	params.Set("user.fields", "id,name,username,description,public_metrics,verified")
+	params.Set("user.fields", "id,name,username,description,public_metrics,verified,verified_type,created_at,profile_image_url")
 	params.Set("expansions", "author_id,referenced_tweets.id")
 
 	if opts.SinceID != "" {
 		params.Set("since_id", opts.SinceID)
 	}
+	if opts.NextToken != "" {
+		params.Set("next_token", opts.NextToken)
+	}
+
+	// Recent search covers the last 7 days and rejects times outside them
+	now := time.Now()
+	if !opts.StartTime.IsZero() && opts.StartTime.After(now.Add(-7*24*time.Hour)) {
+		params.Set("start_time", opts.StartTime.UTC().Format(time.RFC3339))
+	}
+	if !opts.EndTime.IsZero() && opts.EndTime.Before(now.Add(-time.Minute)) {
+		params.Set("end_time", opts.EndTime.UTC().Format(time.RFC3339))
+	}
 
-	endpoint := baseURL + searchEndpoint + "?" + params.Encode()
+	endpoint := c.apiURL + searchEndpoint + "?" + params.Encode()
 	fmt.Printf("[Twitter API] Searching: %s\n", c.buildSearchQuery(query, opts))
 
 	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
@@ -124,7 +143,13 @@ func (c *APIClient) SearchTweets(ctx context.Context, query string, opts ports.S
 	// Convert to domain tweets
 	tweets := make([]domain.Tweet, 0, len(searchResp.Data))
 	for _, t := range searchResp.Data {
-		tweets = append(tweets, t.ToDomainTweet(users))
+		tweet := t.ToDomainTweet(users)
+
+		// v2 has no engagement operators, so minimums are applied here
+		if tweet.LikeCount < opts.MinLikes || tweet.RetweetCount < opts.MinRetweets {
+			continue
+		}
+		tweets = append(tweets, tweet)
 	}
 
 	// Sort by view count if requested
@@ -159,24 +184,17 @@ func (c *APIClient) buildSearchQuery(query string, opts ports.SearchOptions) str
 	if opts.ExcludeRetweets {
 		parts = append(parts, "-is:retweet")
 	}
-	if opts.MinLikes > 0 {
-		parts = append(parts, fmt.Sprintf("min_faves:%d", opts.MinLikes))
-	}
-	if opts.MinRetweets > 0 {
-		parts = append(parts, fmt.Sprintf("min_retweets:%d", opts.MinRetweets))
-	}
-
 	return strings.Join(parts, " ")
 }
 
 // GetTweet retrieves a single tweet by ID
 func (c *APIClient) GetTweet(ctx context.Context, tweetID string) (*domain.Tweet, error) {
 	params := url.Values{}
-	params.Set("tweet.fields", "id,text,author_id,created_at,conversation_id,public_metrics,lang")
+	params.Set("tweet.fields", "id,text,author_id,created_at,conversation_id,public_metrics,lang,referenced_tweets")
 	params.Set("user.fields", "id,name,username,description,public_metrics")
 	params.Set("expansions", "author_id")
 
-	endpoint := baseURL + tweetEndpoint + "/" + tweetID + "?" + params.Encode()
+	endpoint := c.apiURL + tweetEndpoint + "/" + tweetID + "?" + params.Encode()
 	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
@@ -202,7 +220,8 @@ func (c *APIClient) GetTweet(ctx context.Context, tweetID string) (*domain.Tweet
 	return &tweet, nil
 }
 
-// GetTweetThread retrieves the conversation thread for a tweet
+// GetTweetThread retrieves the conversation around a tweet: its parent chain
+// (oldest first), the tweet itself, then its top replies
 func (c *APIClient) GetTweetThread(ctx context.Context, tweetID string) ([]domain.Tweet, error) {
 	tweet, err := c.GetTweet(ctx, tweetID)
 	if err != nil {
@@ -213,18 +232,29 @@ func (c *APIClient) GetTweetThread(ctx context.Context, tweetID string) ([]domai
 		return []domain.Tweet{*tweet}, nil
 	}
 
-	// Search for tweets in the conversation
+	// Search for tweets in the conversation (recent search covers the last 7 days)
+	var conversation []domain.Tweet
 	query := fmt.Sprintf("conversation_id:%s", tweet.ConversationID)
-	result, err := c.SearchTweets(ctx, query, ports.SearchOptions{MaxResults: 50})
-	if err != nil {
-		return []domain.Tweet{*tweet}, nil // Return just the tweet if thread fetch fails
+	if result, err := c.SearchTweets(ctx, query, ports.SearchOptions{MaxResults: 100}); err == nil {
+		conversation = result.Tweets
+	} else {
+		fmt.Printf("[Twitter API] Conversation lookup failed for %s: %v\n", tweetID, err)
 	}
 
-	return result.Tweets, nil
+	// The root is not returned by the search; older parents may have aged out of it
+	lookup := func(id string) *domain.Tweet {
+		parent, err := c.GetTweet(ctx, id)
+		if err != nil {
+			return nil
+		}
+		return parent
+	}
+
+	return buildThread(*tweet, conversation, lookup), nil
 }
 
 // PostReply posts a reply to a tweet
-func (c *APIClient) PostReply(ctx context.Context, tweetID string, text string) (*domain.Reply, error) {
+func (c *APIClient) PostReply(ctx context.Context, tweetID string, text string, media ...domain.MediaAttachment) (*domain.Reply, error) {
 	reqBody := CreateTweetRequest{
 		Text: text,
 		Reply: &ReplyOptions{
@@ -232,12 +262,20 @@ func (c *APIClient) PostReply(ctx context.Context, tweetID string, text string)
 		},
 	}
 
+	mediaIDs, err := c.uploadAll(ctx, media)
+	if err != nil {
+		return nil, err
+	}
+	if len(mediaIDs) > 0 {
+		reqBody.Media = &MediaOptions{MediaIDs: mediaIDs}
+	}
+
 	body, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.doRequest(ctx, "POST", baseURL+tweetEndpoint, body)
+	resp, err := c.doRequest(ctx, "POST", c.apiURL+tweetEndpoint, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to post reply: %w", err)
 	}
@@ -263,12 +301,117 @@ func (c *APIClient) PostReply(ctx context.Context, tweetID string, text string)
 	}, nil
 }
 
+// PostTweet posts a standalone tweet
+func (c *APIClient) PostTweet(ctx context.Context, text string, media ...domain.MediaAttachment) (*domain.Tweet, error) {
+	reqBody := CreateTweetRequest{Text: text}
+
+	mediaIDs, err := c.uploadAll(ctx, media)
+	if err != nil {
+		return nil, err
+	}
+	if len(mediaIDs) > 0 {
+		reqBody.Media = &MediaOptions{MediaIDs: mediaIDs}
+	}
+
+	return c.createTweet(ctx, reqBody)
+}
+
+// PostQuoteTweet posts a tweet quoting another tweet
+func (c *APIClient) PostQuoteTweet(ctx context.Context, tweetID string, text string) (*domain.Tweet, error) {
+	return c.createTweet(ctx, CreateTweetRequest{Text: text, QuoteTweetID: tweetID})
+}
+
+// PostThread posts texts as a thread, each replying to the previous one.
+// Tweets posted before a failure are returned along with the error.
+func (c *APIClient) PostThread(ctx context.Context, texts []string) ([]domain.Tweet, error) {
+	var posted []domain.Tweet
+	for i, text := range texts {
+		req := CreateTweetRequest{Text: text}
+		if i > 0 {
+			req.Reply = &ReplyOptions{InReplyToTweetID: posted[i-1].ID}
+		}
+
+		tweet, err := c.createTweet(ctx, req)
+		if err != nil {
+			return posted, fmt.Errorf("thread tweet %d/%d: %w", i+1, len(texts), err)
+		}
+		if i > 0 {
+			tweet.InReplyToID = posted[i-1].ID
+			tweet.ConversationID = posted[0].ID
+		}
+		posted = append(posted, *tweet)
+	}
+	return posted, nil
+}
+
+// DeleteTweet deletes one of the account's tweets via DELETE /2/tweets/:id
+func (c *APIClient) DeleteTweet(ctx context.Context, tweetID string) error {
+	resp, err := c.doSignedRequest(ctx, "DELETE", c.apiURL+tweetEndpoint+"/"+tweetID, nil, "")
+	if err != nil {
+		// Already deleted on X
+		var statusErr *apiStatusError
+		if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+			return domain.ErrTweetNotFound
+		}
+		return fmt.Errorf("failed to delete tweet: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result DeleteTweetResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+
+	if len(result.Errors) > 0 {
+		if apiErr := result.Errors[0]; apiErr.Status == http.StatusNotFound || apiErr.Title == "Not Found Error" {
+			return domain.ErrTweetNotFound
+		}
+		return fmt.Errorf("delete failed: %s", result.Errors[0].Detail)
+	}
+	if !result.Data.Deleted {
+		return fmt.Errorf("delete failed: tweet not deleted")
+	}
+	return nil
+}
+
+// createTweet posts a tweet via POST /2/tweets
+func (c *APIClient) createTweet(ctx context.Context, reqBody CreateTweetRequest) (*domain.Tweet, error) {
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(ctx, "POST", c.apiURL+tweetEndpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to post tweet: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result CreateTweetResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	if len(result.Errors) > 0 {
+		return nil, fmt.Errorf("tweet failed: %s", result.Errors[0].Detail)
+	}
+	if result.Data == nil {
+		return nil, fmt.Errorf("tweet failed: empty response")
+	}
+
+	return &domain.Tweet{
+		ID:        result.Data.ID,
+		Text:      reqBody.Text,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
 // GetProfile returns the authenticated user's profile
 func (c *APIClient) GetProfile(ctx context.Context) (*domain.User, error) {
 	params := url.Values{}
-	params.Set("user.fields", "id,name,username,description,public_metrics,verified")
+	params.Set("user.fields", "id,name,username,description,public_metrics,verified,verified_type,created_at,profile_image_url")
 
-	endpoint := baseURL + usersMe + "?" + params.Encode()
+	endpoint := c.apiURL + usersMe + "?" + params.Encode()
 	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
@@ -286,12 +429,31 @@ func (c *APIClient) GetProfile(ctx context.Context) (*domain.User, error) {
 	return &user, nil
 }
 
+// authenticatedUserID returns the ID of the credentials' user, calling
+// /users/me only the first time
+func (c *APIClient) authenticatedUserID(ctx context.Context) (string, error) {
+	c.userMu.Lock()
+	defer c.userMu.Unlock()
+
+	if c.userID == "" {
+		profile, err := c.GetProfile(ctx)
+		if err != nil {
+			return "", err
+		}
+		if profile.ID == "" {
+			return "", fmt.Errorf("failed to look up the authenticated user")
+		}
+		c.userID = profile.ID
+	}
+	return c.userID, nil
+}
+
 // GetUser retrieves a user by username
 func (c *APIClient) GetUser(ctx context.Context, username string) (*domain.User, error) {
 	params := url.Values{}
-	params.Set("user.fields", "id,name,username,description,public_metrics,verified")
+	params.Set("user.fields", "id,name,username,description,public_metrics,verified,verified_type,created_at,profile_image_url")
 
-	endpoint := baseURL + "/users/by/username/" + username + "?" + params.Encode()
+	endpoint := c.apiURL + "/users/by/username/" + username + "?" + params.Encode()
 	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
@@ -319,6 +481,7 @@ func (c *APIClient) GetTweetMetrics(ctx context.Context, tweetID string) (*domai
 	return &domain.TweetMetrics{
 		TweetID:      tweetID,
 		Timestamp:    time.Now(),
+		Impressions:  tweet.ViewCount,
 		LikeCount:    tweet.LikeCount,
 		RetweetCount: tweet.RetweetCount,
 		ReplyCount:   tweet.ReplyCount,
@@ -339,7 +502,7 @@ func (c *APIClient) GetMyTweets(ctx context.Context, opts ports.PaginationOption
 		params.Set("pagination_token", opts.Cursor)
 	}
 
-	endpoint := baseURL + "/users/" + profile.ID + "/tweets?" + params.Encode()
+	endpoint := c.apiURL + "/users/" + profile.ID + "/tweets?" + params.Encode()
 	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
@@ -359,6 +522,53 @@ func (c *APIClient) GetMyTweets(ctx context.Context, opts ports.PaginationOption
 	return tweets, nil
 }
 
+// GetMentions retrieves tweets mentioning the authenticated user, newest first
+func (c *APIClient) GetMentions(ctx context.Context, opts ports.MentionsOptions) ([]domain.Tweet, error) {
+	userID, err := c.authenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("max_results", fmt.Sprintf("%d", max(5, min(opts.MaxResults, 100))))
+	params.Set("tweet.fields", "id,text,author_id,created_at,conversation_id,public_metrics,lang,referenced_tweets")
+	params.Set("user.fields", "id,name,username,description,public_metrics,verified,verified_type,created_at,profile_image_url")
+	params.Set("expansions", "author_id")
+	if opts.SinceID != "" {
+		params.Set("since_id", opts.SinceID)
+	}
+
+	endpoint := c.apiURL + "/users/" + userID + "/mentions?" + params.Encode()
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result SearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	if len(result.Errors) > 0 && len(result.Data) == 0 {
+		return nil, fmt.Errorf("API error: %s", result.Errors[0].Detail)
+	}
+
+	users := make(map[string]UserData)
+	if result.Includes != nil {
+		for _, u := range result.Includes.Users {
+			users[u.ID] = u
+		}
+	}
+
+	tweets := make([]domain.Tweet, 0, len(result.Data))
+	for _, t := range result.Data {
+		tweets = append(tweets, t.ToDomainTweet(users))
+	}
+
+	return tweets, nil
+}
+
 // GetRateLimitStatus returns current rate limit status
 func (c *APIClient) GetRateLimitStatus() *domain.RateLimitStatus {
 	c.rateLimitMu.RLock()
@@ -392,6 +602,31 @@ func (c *APIClient) doRequest(ctx context.Context, method, reqURL string, body [
 	}
 	req.Header.Set("Content-Type", "application/json")
 
+	return c.send(req)
+}
+
+// doSignedRequest sends a request signed with OAuth 1.0a user context.
+// Query parameters are signed; multipart bodies are not, per the OAuth spec.
+func (c *APIClient) doSignedRequest(ctx context.Context, method, reqURL string, body io.Reader, contentType string) (*http.Response, error) {
+	if !hasOAuthCredentials(c.credentials) {
+		return nil, fmt.Errorf("OAuth credentials required")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", generateOAuthHeader(c.credentials, method, reqURL))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	return c.send(req)
+}
+
+// send executes a request and converts error statuses to errors
+func (c *APIClient) send(req *http.Request) (*http.Response, error) {
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, err
@@ -401,6 +636,7 @@ func (c *APIClient) doRequest(ctx context.Context, method, reqURL string, body [
 	c.updateRateLimit(resp)
 
 	if resp.StatusCode == 429 {
+		resp.Body.Close()
 		return nil, domain.ErrRateLimited
 	}
 
@@ -415,13 +651,23 @@ func (c *APIClient) doRequest(ctx context.Context, method, reqURL string, body [
 		case 403:
 			return nil, fmt.Errorf("access denied: your API access level may not support this endpoint")
 		default:
-			return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(respBody))
+			return nil, &apiStatusError{StatusCode: resp.StatusCode, Body: string(respBody)}
 		}
 	}
 
 	return resp, nil
 }
 
+// apiStatusError is an error status returned by the API
+type apiStatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *apiStatusError) Error() string {
+	return fmt.Sprintf("API error %d: %s", e.StatusCode, e.Body)
+}
+
 func (c *APIClient) updateRateLimit(resp *http.Response) {
 	c.rateLimitMu.Lock()
 	defer c.rateLimitMu.Unlock()
@@ -0,0 +1,108 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+// newAPIStub serves API v2 requests with handler and returns a client pointed at it
+func newAPIStub(t *testing.T, handler http.HandlerFunc) *APIClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewAPIClient(domain.APICredentials{
+		BearerToken:  "bearer",
+		APIKey:       "key",
+		APISecret:    "secret",
+		AccessToken:  "token",
+		AccessSecret: "token-secret",
+	})
+	if err != nil {
+		t.Fatalf("NewAPIClient: %v", err)
+	}
+	client.apiURL = server.URL + "/2"
+	return client
+}
+
+func TestDeleteTweet(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr error
+	}{
+		{"deleted", http.StatusOK, `{"data":{"deleted":true}}`, nil},
+		{"404 status", http.StatusNotFound, `{"title":"Not Found Error","status":404}`, domain.ErrTweetNotFound},
+		{"not found in body", http.StatusOK, `{"errors":[{"title":"Not Found Error","detail":"Could not find tweet with id: [1846987139428634858].","type":"https://api.twitter.com/2/problems/resource-not-found"}]}`, domain.ErrTweetNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newAPIStub(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/2/tweets/1846987139428634858" {
+					t.Errorf("request = %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+
+			err := client.DeleteTweet(context.Background(), "1846987139428634858")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeleteTweetForbidden(t *testing.T) {
+	client := newAPIStub(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	err := client.DeleteTweet(context.Background(), "1846987139428634858")
+	if err == nil || errors.Is(err, domain.ErrTweetNotFound) {
+		t.Errorf("err = %v, want a non-not-found error", err)
+	}
+}
+
+func TestGetMentionsCachesUserID(t *testing.T) {
+	var mu sync.Mutex
+	requests := make(map[string]int)
+	client := newAPIStub(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.Path]++
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/2/users/me":
+			w.Write([]byte(`{"data":{"id":"2244994945","username":"golangdev","name":"Go Developers"}}`))
+		case "/2/users/2244994945/mentions":
+			w.Write([]byte(`{"data":[{"id":"1846987139428634858","text":"@golangdev nice","author_id":"1"}]}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	for i := 0; i < 3; i++ {
+		tweets, err := client.GetMentions(context.Background(), ports.MentionsOptions{MaxResults: 20})
+		if err != nil {
+			t.Fatalf("GetMentions: %v", err)
+		}
+		if len(tweets) != 1 {
+			t.Fatalf("got %d mentions, want 1", len(tweets))
+		}
+	}
+
+	if requests["/2/users/me"] != 1 || requests["/2/users/2244994945/mentions"] != 3 {
+		t.Errorf("requests = %v, want /users/me once and mentions 3 times", requests)
+	}
+}
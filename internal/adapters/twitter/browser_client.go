@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -24,8 +25,13 @@ type BrowserClient struct {
 	browser       *rod.Browser
 	page          *rod.Page
 	authenticated bool
+	username      string // Logged-in handle, resolved on first GetProfile
 	rateLimitMu   sync.RWMutex
 	rateLimit     *domain.RateLimitStatus
+
+	// pageMu serializes operations on the page. Workers and background services
+	// share the client, and each operation navigates the one page.
+	pageMu sync.Mutex
 }
 
 // NewBrowserClient creates a new browser-based Twitter client
@@ -38,6 +44,9 @@ func NewBrowserClient(auth domain.BrowserAuth) (*BrowserClient, error) {
 
 // Authenticate sets up browser with cookies and verifies login
 func (c *BrowserClient) Authenticate(ctx context.Context) error {
+	c.pageMu.Lock()
+	defer c.pageMu.Unlock()
+
 	// Launch browser
 	l := launcher.New().Headless(true)
 	if c.auth.ProxyURL != "" {
@@ -68,6 +77,11 @@ func (c *BrowserClient) Authenticate(ctx context.Context) error {
 		}
 	}
 
+	// Fail fast if the session cookie has already expired
+	if c.sessionCookieExpired() {
+		return fmt.Errorf("%w: auth_token expired", domain.ErrCookiesExpired)
+	}
+
 	// Set cookies
 	for _, cookie := range c.auth.Cookies {
 		err := c.browser.SetCookies([]*proto.NetworkCookieParam{{
@@ -101,7 +115,12 @@ func (c *BrowserClient) Authenticate(ctx context.Context) error {
 		_, err2 := c.page.Timeout(5 * time.Second).Element("[data-testid='SideNav_AccountSwitcher_Button']")
 		if err2 != nil {
 			fmt.Printf("[browser] Auth verification failed\n")
-			return fmt.Errorf("login verification failed - cookies may be expired: %w", err)
+			// X redirects to the login flow when the session is no longer valid
+			if info, infoErr := c.page.Info(); infoErr == nil && strings.Contains(info.URL, "/login") {
+				return fmt.Errorf("%w: redirected to login", domain.ErrCookiesExpired)
+			}
+			// Timeouts and network errors say nothing about the cookies
+			return fmt.Errorf("login verification failed: %w", err)
 		}
 	}
 
@@ -110,6 +129,16 @@ func (c *BrowserClient) Authenticate(ctx context.Context) error {
 	return nil
 }
 
+// sessionCookieExpired returns true if the auth_token cookie has a past expiry
+func (c *BrowserClient) sessionCookieExpired() bool {
+	for _, cookie := range c.auth.Cookies {
+		if cookie.Name == "auth_token" && cookie.Expires > 0 {
+			return time.Unix(cookie.Expires, 0).Before(time.Now())
+		}
+	}
+	return false
+}
+
 // IsAuthenticated returns whether client is authenticated
 func (c *BrowserClient) IsAuthenticated() bool {
 	return c.authenticated
@@ -122,6 +151,9 @@ func (c *BrowserClient) GetAuthType() domain.AuthType {
 
 // SearchTweets searches for tweets using browser automation
 func (c *BrowserClient) SearchTweets(ctx context.Context, query string, opts ports.SearchOptions) (*domain.SearchResult, error) {
+	c.pageMu.Lock()
+	defer c.pageMu.Unlock()
+
 	if !c.authenticated {
 		return nil, domain.ErrNotAuthenticated
 	}
@@ -130,6 +162,9 @@ func (c *BrowserClient) SearchTweets(ctx context.Context, query string, opts por
 	searchURL := c.buildSearchURL(query, opts)
 	fmt.Printf("[browser] Navigating to: %s\n", searchURL)
 
+	capture := c.captureGraphQL(gqlSearchTimeline)
+	defer capture.Stop()
+
 	if err := c.page.Navigate(searchURL); err != nil {
 		return nil, fmt.Errorf("failed to navigate to search: %w", err)
 	}
@@ -161,10 +196,28 @@ func (c *BrowserClient) SearchTweets(ctx context.Context, query string, opts por
 		fmt.Printf("[browser] Page HTML length: %d\n", len(html))
 	}
 
-	// Extract tweets from page
-	tweets, err := c.extractTweets(opts.MaxResults)
-	if err != nil {
-		return nil, fmt.Errorf("failed to extract tweets: %w", err)
+	// Prefer the intercepted API response; fall back to the rendered page
+	var tweets []domain.Tweet
+	if capture.Wait(5 * time.Second) {
+		tweets = capture.Tweets()
+		if opts.MaxResults > 0 && len(tweets) > opts.MaxResults {
+			tweets = tweets[:opts.MaxResults]
+		}
+		fmt.Printf("[browser] Parsed %d tweets from %d GraphQL response(s)\n", len(tweets), capture.Count())
+	}
+	if len(tweets) == 0 {
+		var err error
+		tweets, err = c.extractTweets(opts.MaxResults)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract tweets: %w", err)
+		}
+	}
+
+	// View counts are only known from the GraphQL response
+	if opts.SortByViews {
+		sort.SliceStable(tweets, func(i, j int) bool {
+			return tweets[i].ViewCount > tweets[j].ViewCount
+		})
 	}
 
 	fmt.Printf("[browser] Extracted %d tweets for query: %s\n", len(tweets), query)
@@ -178,6 +231,11 @@ func (c *BrowserClient) SearchTweets(ctx context.Context, query string, opts por
 }
 
 func (c *BrowserClient) buildSearchURL(query string, opts ports.SearchOptions) string {
+	// The web search has no page tokens, but supports since_id as an operator
+	if opts.SinceID != "" {
+		query += " since_id:" + opts.SinceID
+	}
+
 	params := url.Values{}
 	params.Set("q", query)
 	params.Set("f", "live") // Latest tweets
@@ -254,6 +312,15 @@ func (c *BrowserClient) parseTweetElement(el *rod.Element) (domain.Tweet, error)
 		}
 	}
 
+	// Use the posted time when shown
+	if timeEl, err := el.Element("time[datetime]"); err == nil {
+		if dt, _ := timeEl.Attribute("datetime"); dt != nil {
+			if parsed, err := time.Parse(time.RFC3339, *dt); err == nil {
+				tweet.CreatedAt = parsed
+			}
+		}
+	}
+
 	// Extract metrics
 	tweet.LikeCount = c.extractMetric(el, "[data-testid='like']")
 	tweet.RetweetCount = c.extractMetric(el, "[data-testid='retweet']")
@@ -277,13 +344,17 @@ func (c *BrowserClient) extractMetric(el *rod.Element, selector string) int {
 		return 0
 	}
 
-	text = strings.TrimSpace(text)
+	return parseCount(text)
+}
+
+// parseCount parses a displayed count such as "1,234", "12.5K" or "3M"
+func parseCount(text string) int {
+	text = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(text), ",", ""))
 	if text == "" {
 		return 0
 	}
 
-	// Handle K/M suffixes
-	text = strings.ToUpper(text)
+	// Handle K/M/B suffixes
 	multiplier := 1
 	if strings.HasSuffix(text, "K") {
 		multiplier = 1000
@@ -291,6 +362,9 @@ func (c *BrowserClient) extractMetric(el *rod.Element, selector string) int {
 	} else if strings.HasSuffix(text, "M") {
 		multiplier = 1000000
 		text = strings.TrimSuffix(text, "M")
+	} else if strings.HasSuffix(text, "B") {
+		multiplier = 1000000000
+		text = strings.TrimSuffix(text, "B")
 	}
 
 	val, _ := strconv.ParseFloat(text, 64)
@@ -299,6 +373,17 @@ func (c *BrowserClient) extractMetric(el *rod.Element, selector string) int {
 
 // GetTweet retrieves a single tweet by ID
 func (c *BrowserClient) GetTweet(ctx context.Context, tweetID string) (*domain.Tweet, error) {
+	c.pageMu.Lock()
+	defer c.pageMu.Unlock()
+
+	return c.getTweet(tweetID)
+}
+
+// getTweet loads a tweet's page; the caller holds pageMu
+func (c *BrowserClient) getTweet(tweetID string) (*domain.Tweet, error) {
+	capture := c.captureGraphQL(gqlTweetDetail)
+	defer capture.Stop()
+
 	// Navigate to tweet page and extract
 	url := fmt.Sprintf("https://x.com/i/web/status/%s", tweetID)
 	if err := c.page.Navigate(url); err != nil {
@@ -308,6 +393,14 @@ func (c *BrowserClient) GetTweet(ctx context.Context, tweetID string) (*domain.T
 		return nil, err
 	}
 
+	if capture.Wait(5 * time.Second) {
+		for _, t := range capture.Tweets() {
+			if t.ID == tweetID {
+				return &t, nil
+			}
+		}
+	}
+
 	time.Sleep(2 * time.Second)
 
 	el, err := c.page.Element("[data-testid='tweet']")
@@ -323,17 +416,118 @@ func (c *BrowserClient) GetTweet(ctx context.Context, tweetID string) (*domain.T
 	return &tweet, nil
 }
 
-// GetTweetThread retrieves conversation thread
+// GetTweetThread loads the conversation page: the parent chain shown above the
+// tweet (oldest first), the tweet itself, then the top replies below it
 func (c *BrowserClient) GetTweetThread(ctx context.Context, tweetID string) ([]domain.Tweet, error) {
-	tweet, err := c.GetTweet(ctx, tweetID)
-	if err != nil {
+	c.pageMu.Lock()
+	defer c.pageMu.Unlock()
+
+	capture := c.captureGraphQL(gqlTweetDetail)
+	defer capture.Stop()
+
+	url := fmt.Sprintf("https://x.com/i/web/status/%s", tweetID)
+	if err := c.page.Navigate(url); err != nil {
+		return nil, err
+	}
+	if err := c.page.WaitLoad(); err != nil {
 		return nil, err
 	}
-	return []domain.Tweet{*tweet}, nil
+
+	// The intercepted response carries the real reply links
+	if capture.Wait(5 * time.Second) {
+		conversation := capture.Tweets()
+		for _, t := range conversation {
+			if t.ID == tweetID {
+				thread := buildThread(t, conversation, func(string) *domain.Tweet { return nil })
+				fmt.Printf("[browser] Parsed thread for %s: %d tweet(s)\n", tweetID, len(thread))
+				return thread, nil
+			}
+		}
+	}
+
+	return c.scrapeThread(ctx, tweetID)
+}
+
+// scrapeThread reads the conversation from the rendered page
+func (c *BrowserClient) scrapeThread(ctx context.Context, tweetID string) ([]domain.Tweet, error) {
+	// Wait for the conversation to render (up to 10 seconds)
+	for i := 0; i < 10; i++ {
+		if el, _ := c.page.Timeout(1 * time.Second).Element("[data-testid='tweet']"); el != nil {
+			break
+		}
+		time.Sleep(1 * time.Second)
+	}
+	time.Sleep(2 * time.Second)
+
+	// Tweets render in page order; the list is virtualized, so collect while scrolling
+	var ordered []domain.Tweet
+	seen := make(map[string]bool)
+	focus := -1
+	for scroll := 0; scroll < 4; scroll++ {
+		elements, err := c.page.Elements("[data-testid='tweet']")
+		if err != nil {
+			break
+		}
+		for _, el := range elements {
+			tweet, err := c.parseTweetElement(el)
+			if err != nil || seen[tweet.ID] {
+				continue
+			}
+			seen[tweet.ID] = true
+			if tweet.ID == tweetID {
+				focus = len(ordered)
+			}
+			ordered = append(ordered, tweet)
+		}
+
+		if focus >= 0 && len(ordered)-focus-1 >= maxThreadReplies {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		c.page.Mouse.Scroll(0, 1500, 5)
+		time.Sleep(1500 * time.Millisecond)
+	}
+
+	if len(ordered) == 0 {
+		return nil, domain.ErrTweetNotFound
+	}
+	if focus < 0 {
+		fmt.Printf("[browser] Tweet %s not found on its conversation page\n", tweetID)
+		return ordered[:1], nil
+	}
+
+	// Link the chain above the tweet and treat tweets below it as its replies
+	conversationID := ordered[0].ID
+	for i := range ordered {
+		ordered[i].ConversationID = conversationID
+		switch {
+		case i > 0 && i <= focus:
+			ordered[i].InReplyToID = ordered[i-1].ID
+		case i > focus:
+			ordered[i].InReplyToID = tweetID
+		}
+	}
+
+	thread := buildThread(ordered[focus], ordered, func(string) *domain.Tweet { return nil })
+	fmt.Printf("[browser] Extracted thread for %s: %d tweet(s)\n", tweetID, len(thread))
+	return thread, nil
 }
 
 // PostReply posts a reply to a tweet
-func (c *BrowserClient) PostReply(ctx context.Context, tweetID string, text string) (*domain.Reply, error) {
+func (c *BrowserClient) PostReply(ctx context.Context, tweetID string, text string, media ...domain.MediaAttachment) (*domain.Reply, error) {
+	c.pageMu.Lock()
+	defer c.pageMu.Unlock()
+
+	if err := ValidateMedia(media); err != nil {
+		return nil, err
+	}
+
 	// Navigate to tweet
 	url := fmt.Sprintf("https://x.com/i/web/status/%s", tweetID)
 	if err := c.page.Navigate(url); err != nil {
@@ -367,36 +561,191 @@ func (c *BrowserClient) PostReply(ctx context.Context, tweetID string, text stri
 
 	time.Sleep(500 * time.Millisecond)
 
-	// Click post button
-	postBtn, err := c.page.Element("[data-testid='tweetButton']")
+	if err := c.attachMedia(media); err != nil {
+		return nil, err
+	}
+
+	ids, err := c.submitPost(1)
 	if err != nil {
-		return nil, fmt.Errorf("post button not found: %w", err)
+		return nil, err
 	}
-	if err := postBtn.Click(proto.InputMouseButtonLeft, 1); err != nil {
+
+	now := time.Now()
+	return &domain.Reply{
+		TweetID:       tweetID,
+		Text:          text,
+		Status:        domain.ReplyStatusPosted,
+		PostedAt:      &now,
+		PostedReplyID: firstID(ids),
+	}, nil
+}
+
+// PostTweet posts a standalone tweet using the compose dialog
+func (c *BrowserClient) PostTweet(ctx context.Context, text string, media ...domain.MediaAttachment) (*domain.Tweet, error) {
+	c.pageMu.Lock()
+	defer c.pageMu.Unlock()
+
+	if err := ValidateMedia(media); err != nil {
+		return nil, err
+	}
+	ids, err := c.composeAndPost([]string{text}, media)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Tweet{
+		ID:        firstID(ids),
+		Text:      text,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// PostQuoteTweet quotes a tweet via its retweet menu
+func (c *BrowserClient) PostQuoteTweet(ctx context.Context, tweetID string, text string) (*domain.Tweet, error) {
+	c.pageMu.Lock()
+	defer c.pageMu.Unlock()
+
+	url := fmt.Sprintf("https://x.com/i/web/status/%s", tweetID)
+	if err := c.page.Navigate(url); err != nil {
+		return nil, err
+	}
+	if err := c.page.WaitLoad(); err != nil {
 		return nil, err
 	}
 
 	time.Sleep(2 * time.Second)
 
-	now := time.Now()
-	return &domain.Reply{
-		TweetID:  tweetID,
-		Text:     text,
-		Status:   domain.ReplyStatusPosted,
-		PostedAt: &now,
+	// Open retweet menu
+	retweetBtn, err := c.page.Element("[data-testid='retweet']")
+	if err != nil {
+		return nil, fmt.Errorf("retweet button not found: %w", err)
+	}
+	if err := retweetBtn.Click(proto.InputMouseButtonLeft, 1); err != nil {
+		return nil, err
+	}
+
+	time.Sleep(1 * time.Second)
+
+	// Choose "Quote"
+	quoteItem, err := c.page.Element("[role='menuitem'][href*='/compose/post']")
+	if err != nil {
+		return nil, fmt.Errorf("quote option not found: %w", err)
+	}
+	if err := quoteItem.Click(proto.InputMouseButtonLeft, 1); err != nil {
+		return nil, err
+	}
+
+	time.Sleep(1 * time.Second)
+
+	ids, err := c.fillAndPost([]string{text}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Tweet{
+		ID:        firstID(ids),
+		Text:      text,
+		CreatedAt: time.Now(),
 	}, nil
 }
 
-// GetProfile returns the authenticated user's profile
-func (c *BrowserClient) GetProfile(ctx context.Context) (*domain.User, error) {
-	// This would navigate to profile and scrape
-	return nil, fmt.Errorf("GetProfile not implemented for browser client")
+// PostThread posts texts as a single thread from the compose dialog
+func (c *BrowserClient) PostThread(ctx context.Context, texts []string) ([]domain.Tweet, error) {
+	c.pageMu.Lock()
+	defer c.pageMu.Unlock()
+
+	if len(texts) == 0 {
+		return nil, fmt.Errorf("thread is empty")
+	}
+
+	ids, err := c.composeAndPost(texts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	tweets := make([]domain.Tweet, len(texts))
+	for i, text := range texts {
+		tweets[i] = domain.Tweet{Text: text, CreatedAt: now}
+		if i < len(ids) {
+			tweets[i].ID = ids[i]
+		}
+	}
+	return tweets, nil
 }
 
-// GetUser retrieves a user by username
-func (c *BrowserClient) GetUser(ctx context.Context, username string) (*domain.User, error) {
-	url := fmt.Sprintf("https://x.com/%s", username)
+// DeleteTweet deletes one of the account's tweets from its page's "More" menu
+func (c *BrowserClient) DeleteTweet(ctx context.Context, tweetID string) error {
+	c.pageMu.Lock()
+	defer c.pageMu.Unlock()
+
+	url := fmt.Sprintf("https://x.com/i/web/status/%s", tweetID)
 	if err := c.page.Navigate(url); err != nil {
+		return err
+	}
+	if err := c.page.WaitLoad(); err != nil {
+		return err
+	}
+
+	time.Sleep(2 * time.Second)
+
+	// A reply's page shows the tweets it replies to above it
+	articles, err := c.page.Elements("article[data-testid='tweet']")
+	if err != nil {
+		return err
+	}
+	var article *rod.Element
+	for _, el := range articles {
+		if has, _, _ := el.Has(fmt.Sprintf("a[href$='/status/%s'] time", tweetID)); has {
+			article = el
+			break
+		}
+	}
+	if article == nil {
+		return domain.ErrTweetNotFound
+	}
+
+	caret, err := article.Element("[data-testid='caret']")
+	if err != nil {
+		return fmt.Errorf("more button not found: %w", err)
+	}
+	if err := caret.Click(proto.InputMouseButtonLeft, 1); err != nil {
+		return err
+	}
+
+	time.Sleep(500 * time.Millisecond)
+
+	deleteItem, err := c.page.Timeout(5*time.Second).ElementR("[role='menuitem']", "^Delete$")
+	if err != nil {
+		return fmt.Errorf("delete menu item not found: %w", err)
+	}
+	if err := deleteItem.Click(proto.InputMouseButtonLeft, 1); err != nil {
+		return err
+	}
+
+	time.Sleep(500 * time.Millisecond)
+
+	capture := c.captureGraphQL(gqlDeleteTweet)
+	defer capture.Stop()
+
+	confirmBtn, err := c.page.Timeout(5 * time.Second).Element("[data-testid='confirmationSheetConfirm']")
+	if err != nil {
+		return fmt.Errorf("delete confirmation not found: %w", err)
+	}
+	if err := confirmBtn.Click(proto.InputMouseButtonLeft, 1); err != nil {
+		return err
+	}
+
+	if !capture.Wait(10 * time.Second) {
+		return domain.ErrDeleteUnconfirmed
+	}
+	return parseDeleteTweet(capture.Bodies()[0])
+}
+
+// composeAndPost opens the compose dialog and posts one or more texts,
+// attaching media to the first. Returns the IDs of the new tweets.
+func (c *BrowserClient) composeAndPost(texts []string, media []domain.MediaAttachment) ([]string, error) {
+	if err := c.page.Navigate("https://x.com/compose/post"); err != nil {
 		return nil, err
 	}
 	if err := c.page.WaitLoad(); err != nil {
@@ -405,17 +754,306 @@ func (c *BrowserClient) GetUser(ctx context.Context, username string) (*domain.U
 
 	time.Sleep(2 * time.Second)
 
+	return c.fillAndPost(texts, media)
+}
+
+// fillAndPost types each text into the open composer, adding a thread entry
+// between texts, then posts them. Media is attached to the first text.
+func (c *BrowserClient) fillAndPost(texts []string, media []domain.MediaAttachment) ([]string, error) {
+	for i, text := range texts {
+		if i > 0 {
+			addBtn, err := c.page.Element("[data-testid='addButton']")
+			if err != nil {
+				return nil, fmt.Errorf("add thread button not found: %w", err)
+			}
+			if err := addBtn.Click(proto.InputMouseButtonLeft, 1); err != nil {
+				return nil, err
+			}
+			time.Sleep(500 * time.Millisecond)
+		}
+
+		// Type tweet text
+		textBox, err := c.page.Element(fmt.Sprintf("[data-testid='tweetTextarea_%d']", i))
+		if err != nil {
+			return nil, fmt.Errorf("tweet text box not found: %w", err)
+		}
+		if err := textBox.Input(text); err != nil {
+			return nil, err
+		}
+
+		time.Sleep(500 * time.Millisecond)
+
+		if i == 0 {
+			if err := c.attachMedia(media); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return c.submitPost(len(texts))
+}
+
+// Toast texts X shows when a post fails, mapped to domain errors
+var postToastErrors = []struct {
+	text string
+	err  error
+}{
+	{"already said that", domain.ErrDuplicatePost},
+	{"limit", domain.ErrRateLimited},
+	{"can reply", domain.ErrReplyRestricted},
+	{"locked", domain.ErrAccountLocked},
+	{"suspended", domain.ErrAccountLocked},
+	{"automated", domain.ErrPostRejected},
+	{"deleted", domain.ErrTweetNotFound},
+	{"something went wrong", domain.ErrReplyFailed},
+}
+
+var statusLinkRe = regexp.MustCompile(`/status/(\d+)`)
+
+// submitPost clicks the composer's post button and confirms the posts from the
+// CreateTweet responses, falling back to the toast X shows. Returns the IDs of
+// the new tweets in order.
+func (c *BrowserClient) submitPost(count int) ([]string, error) {
+	capture := c.captureGraphQL(gqlCreateTweet)
+	defer capture.Stop()
+
+	postBtn, err := c.page.Element("[data-testid='tweetButton']")
+	if err != nil {
+		return nil, fmt.Errorf("post button not found: %w", err)
+	}
+	if err := postBtn.Click(proto.InputMouseButtonLeft, 1); err != nil {
+		return nil, err
+	}
+
+	capture.WaitFor(count, 10*time.Second+time.Duration(count)*5*time.Second)
+
+	var ids []string
+	for _, body := range capture.Bodies() {
+		id, err := parseCreateTweet(body)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) > 0 {
+		return ids, nil
+	}
+
+	// No response seen: read the result from the toast
+	id, err := c.readPostToast()
+	if err != nil {
+		return nil, err
+	}
+	if id == "" {
+		return nil, nil
+	}
+	return []string{id}, nil
+}
+
+// readPostToast returns the tweet ID linked from the "sent" toast, or the error
+// the toast reports. The ID is empty if the toast confirms without a link.
+func (c *BrowserClient) readPostToast() (string, error) {
+	toast, err := c.page.Timeout(5 * time.Second).Element("[data-testid='toast']")
+	if err != nil {
+		return "", domain.ErrPostUnconfirmed
+	}
+
+	if links, err := toast.Elements("a[href*='/status/']"); err == nil && len(links) > 0 {
+		if href, err := links[0].Attribute("href"); err == nil && href != nil {
+			if m := statusLinkRe.FindStringSubmatch(*href); m != nil {
+				return m[1], nil
+			}
+		}
+	}
+
+	text, _ := toast.Text()
+	return "", classifyPostToast(text)
+}
+
+// classifyPostToast maps a toast's text to a domain error; nil if it reports
+// the post was sent
+func classifyPostToast(text string) error {
+	lower := strings.ToLower(text)
+	for _, e := range postToastErrors {
+		if strings.Contains(lower, e.text) {
+			return fmt.Errorf("%w (%s)", e.err, text)
+		}
+	}
+	if strings.Contains(lower, "sent") {
+		return nil
+	}
+	return fmt.Errorf("%w (%s)", domain.ErrPostUnconfirmed, text)
+}
+
+// firstID returns the first of the posted tweet IDs, or "" if none is known
+func firstID(ids []string) string {
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[0]
+}
+
+// attachMedia adds files through the composer's hidden file input and waits for the previews
+func (c *BrowserClient) attachMedia(media []domain.MediaAttachment) error {
+	if len(media) == 0 {
+		return nil
+	}
+
+	paths := make([]string, len(media))
+	for i, m := range media {
+		paths[i] = m.Path
+	}
+
+	fileInput, err := c.page.Element("input[data-testid='fileInput']")
+	if err != nil {
+		return fmt.Errorf("media file input not found: %w", err)
+	}
+	if err := fileInput.SetFiles(paths); err != nil {
+		return fmt.Errorf("failed to attach media: %w", err)
+	}
+
+	// Wait for upload previews before posting
+	if _, err := c.page.Timeout(30 * time.Second).Element("[data-testid='attachments']"); err != nil {
+		return fmt.Errorf("media upload did not finish: %w", err)
+	}
+	time.Sleep(time.Duration(len(media)) * time.Second)
+
+	return nil
+}
+
+// GetProfile returns the authenticated user's profile
+func (c *BrowserClient) GetProfile(ctx context.Context) (*domain.User, error) {
+	c.pageMu.Lock()
+	defer c.pageMu.Unlock()
+
+	username, err := c.myUsername()
+	if err != nil {
+		return nil, err
+	}
+	return c.getUser(username)
+}
+
+// myUsername finds the logged-in handle from the navigation bar's profile link
+func (c *BrowserClient) myUsername() (string, error) {
+	if c.username != "" {
+		return c.username, nil
+	}
+	if !c.authenticated {
+		return "", domain.ErrNotAuthenticated
+	}
+
+	if err := c.page.Navigate("https://x.com/home"); err != nil {
+		return "", err
+	}
+	if err := c.page.WaitLoad(); err != nil {
+		return "", err
+	}
+
+	link, err := c.page.Timeout(10 * time.Second).Element("[data-testid='AppTabBar_Profile_Link']")
+	if err != nil {
+		return "", fmt.Errorf("profile link not found: %w", err)
+	}
+	href, err := link.Attribute("href")
+	if err != nil || href == nil || strings.Trim(*href, "/") == "" {
+		return "", fmt.Errorf("could not read username from profile link")
+	}
+
+	c.username = strings.Trim(*href, "/")
+	return c.username, nil
+}
+
+// GetUser scrapes a user's profile page
+func (c *BrowserClient) GetUser(ctx context.Context, username string) (*domain.User, error) {
+	c.pageMu.Lock()
+	defer c.pageMu.Unlock()
+
+	return c.getUser(username)
+}
+
+// getUser scrapes a profile page; the caller holds pageMu
+func (c *BrowserClient) getUser(username string) (*domain.User, error) {
+	username = strings.TrimPrefix(username, "@")
+	if err := c.openProfile(username); err != nil {
+		return nil, err
+	}
+
 	user := &domain.User{Username: username}
 
-	// Extract follower count
-	// This is simplified - actual implementation would need proper selectors
+	header, err := c.page.Timeout(10 * time.Second).Element("[data-testid='UserName']")
+	if err != nil {
+		return nil, fmt.Errorf("%w: @%s", domain.ErrUserNotFound, username)
+	}
+
+	// The first line of the name block is the display name
+	if text, err := header.Text(); err == nil {
+		if lines := strings.Split(strings.TrimSpace(text), "\n"); len(lines) > 0 {
+			user.Name = strings.TrimSpace(lines[0])
+		}
+	}
+	if has, _, _ := header.Has("[data-testid='icon-verified']"); has {
+		user.Verified = true
+	}
+
+	if bio, err := c.page.Timeout(2 * time.Second).Element("[data-testid='UserDescription']"); err == nil {
+		user.Bio, _ = bio.Text()
+	}
+
+	user.FollowingCount = c.profileLinkCount(username, "following")
+	user.FollowersCount = c.profileLinkCount(username, "verified_followers")
+	if user.FollowersCount == 0 {
+		user.FollowersCount = c.profileLinkCount(username, "followers")
+	}
+
+	// The post count is only shown as "1,234 posts" in the header bar
+	if res, err := c.page.Eval(`() => {
+		const el = [...document.querySelectorAll('[data-testid="primaryColumn"] div[dir="ltr"]')]
+			.find(e => /^[\d.,]+[KMB]? (posts?|tweets?)$/i.test(e.textContent.trim()));
+		return el ? el.textContent.trim() : '';
+	}`); err == nil {
+		if fields := strings.Fields(res.Value.Str()); len(fields) > 0 {
+			user.TweetCount = parseCount(fields[0])
+		}
+	}
 
 	return user, nil
 }
 
+// openProfile navigates to a profile page and waits for it to render
+func (c *BrowserClient) openProfile(username string) error {
+	url := fmt.Sprintf("https://x.com/%s", username)
+	if err := c.page.Navigate(url); err != nil {
+		return err
+	}
+	if err := c.page.WaitLoad(); err != nil {
+		return err
+	}
+
+	time.Sleep(2 * time.Second)
+	return nil
+}
+
+// profileLinkCount reads the count from a profile stat link such as "/user/following"
+func (c *BrowserClient) profileLinkCount(username, path string) int {
+	el, err := c.page.Timeout(2 * time.Second).Element(fmt.Sprintf("a[href='/%s/%s' i]", username, path))
+	if err != nil {
+		return 0
+	}
+	text, err := el.Text()
+	if err != nil {
+		return 0
+	}
+	if fields := strings.Fields(text); len(fields) > 0 {
+		return parseCount(fields[0])
+	}
+	return 0
+}
+
 // GetTweetMetrics retrieves metrics for a tweet
 func (c *BrowserClient) GetTweetMetrics(ctx context.Context, tweetID string) (*domain.TweetMetrics, error) {
-	tweet, err := c.GetTweet(ctx, tweetID)
+	c.pageMu.Lock()
+	defer c.pageMu.Unlock()
+
+	tweet, err := c.getTweet(tweetID)
 	if err != nil {
 		return nil, err
 	}
@@ -423,15 +1061,167 @@ func (c *BrowserClient) GetTweetMetrics(ctx context.Context, tweetID string) (*d
 	return &domain.TweetMetrics{
 		TweetID:      tweetID,
 		Timestamp:    time.Now(),
+		Impressions:  tweet.ViewCount,
 		LikeCount:    tweet.LikeCount,
 		RetweetCount: tweet.RetweetCount,
 		ReplyCount:   tweet.ReplyCount,
 	}, nil
 }
 
-// GetMyTweets retrieves tweets from authenticated user
+// GetMyTweets scrapes the authenticated user's timeline, scrolling until the limit
+// is reached. Cursor is the ID of the last tweet of the previous page.
 func (c *BrowserClient) GetMyTweets(ctx context.Context, opts ports.PaginationOptions) ([]domain.Tweet, error) {
-	return nil, fmt.Errorf("GetMyTweets not implemented for browser client")
+	c.pageMu.Lock()
+	defer c.pageMu.Unlock()
+
+	username, err := c.myUsername()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.openProfile(username); err != nil {
+		return nil, err
+	}
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+
+	var tweets []domain.Tweet
+	seen := make(map[string]bool)
+	idle := 0
+	for scroll := 0; scroll < 30 && idle < 3; scroll++ {
+		elements, err := c.page.Elements("[data-testid='tweet']")
+		if err != nil {
+			return nil, err
+		}
+
+		found := 0
+		for _, el := range elements {
+			tweet, err := c.parseTweetElement(el)
+			if err != nil || seen[tweet.ID] {
+				continue
+			}
+			seen[tweet.ID] = true
+			found++
+
+			// Skip retweets and tweets up to the cursor
+			if !strings.EqualFold(tweet.AuthorUsername, username) || !olderThan(tweet.ID, opts.Cursor) {
+				continue
+			}
+			tweets = append(tweets, tweet)
+			if len(tweets) >= limit {
+				break
+			}
+		}
+
+		if len(tweets) >= limit {
+			break
+		}
+		if found == 0 {
+			idle++
+		} else {
+			idle = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			return tweets, ctx.Err()
+		default:
+		}
+
+		c.page.Mouse.Scroll(0, 2000, 5)
+		time.Sleep(1500 * time.Millisecond)
+	}
+
+	return tweets, nil
+}
+
+// GetMentions scrapes the notifications mentions tab, newest first. The page
+// does not say which tweet a mention replies to, so InReplyToID is left empty.
+func (c *BrowserClient) GetMentions(ctx context.Context, opts ports.MentionsOptions) ([]domain.Tweet, error) {
+	c.pageMu.Lock()
+	defer c.pageMu.Unlock()
+
+	username, err := c.myUsername()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.page.Navigate("https://x.com/notifications/mentions"); err != nil {
+		return nil, err
+	}
+	if err := c.page.WaitLoad(); err != nil {
+		return nil, err
+	}
+
+	limit := opts.MaxResults
+	if limit <= 0 {
+		limit = 20
+	}
+
+	// Wait for the mentions to render; an empty tab has none
+	if _, err := c.page.Timeout(10 * time.Second).Element("[data-testid='tweet']"); err != nil {
+		return nil, nil
+	}
+	time.Sleep(time.Second)
+
+	var mentions []domain.Tweet
+	seen := make(map[string]bool)
+	for scroll := 0; scroll < 5; scroll++ {
+		elements, err := c.page.Elements("[data-testid='tweet']")
+		if err != nil {
+			return nil, err
+		}
+
+		reachedCursor := false
+		for _, el := range elements {
+			tweet, err := c.parseTweetElement(el)
+			if err != nil || seen[tweet.ID] {
+				continue
+			}
+			seen[tweet.ID] = true
+
+			if !olderThan(opts.SinceID, tweet.ID) {
+				reachedCursor = true
+				continue
+			}
+			if strings.EqualFold(tweet.AuthorUsername, username) {
+				continue
+			}
+			mentions = append(mentions, tweet)
+		}
+
+		if reachedCursor || len(mentions) >= limit {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return mentions, ctx.Err()
+		default:
+		}
+
+		c.page.Mouse.Scroll(0, 2000, 5)
+		time.Sleep(1500 * time.Millisecond)
+	}
+
+	if len(mentions) > limit {
+		mentions = mentions[:limit]
+	}
+	return mentions, nil
+}
+
+// olderThan reports whether tweet ID id was posted before cursor. Snowflake IDs
+// grow over time, so a shorter ID (or an equal-length smaller one) is older.
+func olderThan(id, cursor string) bool {
+	if cursor == "" {
+		return true
+	}
+	if len(id) != len(cursor) {
+		return len(id) < len(cursor)
+	}
+	return id < cursor
 }
 
 // GetRateLimitStatus returns current rate limit status
@@ -443,6 +1233,9 @@ func (c *BrowserClient) GetRateLimitStatus() *domain.RateLimitStatus {
 
 // Close cleans up browser resources
 func (c *BrowserClient) Close() error {
+	c.pageMu.Lock()
+	defer c.pageMu.Unlock()
+
 	if c.page != nil {
 		c.page.Close()
 	}
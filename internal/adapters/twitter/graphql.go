@@ -0,0 +1,488 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-rod/rod/lib/proto"
+
+	"xtools/internal/domain"
+)
+
+// X web app GraphQL operations whose responses carry timeline tweets
+const (
+	gqlSearchTimeline = "SearchTimeline"
+	gqlTweetDetail    = "TweetDetail"
+)
+
+// X web app GraphQL mutations sent when a post is submitted or deleted
+const (
+	gqlCreateTweet = "CreateTweet"
+	gqlDeleteTweet = "DeleteTweet"
+)
+
+var gqlOperationRe = regexp.MustCompile(`/graphql/[^/]+/(\w+)`)
+
+// graphqlCapture collects GraphQL response bodies seen by a page
+type graphqlCapture struct {
+	mu     sync.Mutex
+	bodies [][]byte
+	notify chan struct{}
+	cancel context.CancelFunc
+}
+
+// captureGraphQL starts recording responses of the given operations on the
+// client's page. Call it before navigating and Stop it when done.
+func (c *BrowserClient) captureGraphQL(operations ...string) *graphqlCapture {
+	wanted := make(map[string]bool, len(operations))
+	for _, op := range operations {
+		wanted[op] = true
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	g := &graphqlCapture{notify: make(chan struct{}, 1), cancel: cancel}
+	page := c.page.Context(ctx)
+	pending := make(map[proto.NetworkRequestID]bool)
+
+	// The body can only be read once loading has finished
+	wait := page.EachEvent(func(e *proto.NetworkResponseReceived) {
+		if m := gqlOperationRe.FindStringSubmatch(e.Response.URL); m != nil && wanted[m[1]] {
+			pending[e.RequestID] = true
+		}
+	}, func(e *proto.NetworkLoadingFinished) {
+		if !pending[e.RequestID] {
+			return
+		}
+		delete(pending, e.RequestID)
+
+		body, err := proto.NetworkGetResponseBody{RequestID: e.RequestID}.Call(page)
+		if err != nil {
+			return
+		}
+		g.add([]byte(body.Body))
+	})
+	go wait()
+
+	return g
+}
+
+func (g *graphqlCapture) add(body []byte) {
+	g.mu.Lock()
+	g.bodies = append(g.bodies, body)
+	g.mu.Unlock()
+
+	select {
+	case g.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Wait blocks until a response has been captured or the timeout passes
+func (g *graphqlCapture) Wait(timeout time.Duration) bool {
+	if g.Count() > 0 {
+		return true
+	}
+	select {
+	case <-g.notify:
+		return true
+	case <-time.After(timeout):
+		return g.Count() > 0
+	}
+}
+
+// WaitFor blocks until n responses have been captured or the timeout passes
+func (g *graphqlCapture) WaitFor(n int, timeout time.Duration) bool {
+	deadline := time.After(timeout)
+	for g.Count() < n {
+		select {
+		case <-g.notify:
+		case <-deadline:
+			return g.Count() >= n
+		}
+	}
+	return true
+}
+
+// Bodies returns the captured response bodies in order
+func (g *graphqlCapture) Bodies() [][]byte {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([][]byte(nil), g.bodies...)
+}
+
+// Count returns the number of captured responses
+func (g *graphqlCapture) Count() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return len(g.bodies)
+}
+
+// Tweets parses all captured responses, in order, without duplicates
+func (g *graphqlCapture) Tweets() []domain.Tweet {
+	g.mu.Lock()
+	bodies := g.bodies
+	g.mu.Unlock()
+
+	var tweets []domain.Tweet
+	seen := make(map[string]bool)
+	for _, body := range bodies {
+		parsed, err := parseGraphQLTweets(body)
+		if err != nil {
+			fmt.Printf("[browser] Failed to parse GraphQL response: %v\n", err)
+			continue
+		}
+		for _, t := range parsed {
+			if !seen[t.ID] {
+				seen[t.ID] = true
+				tweets = append(tweets, t)
+			}
+		}
+	}
+	return tweets
+}
+
+// Stop stops listening for responses
+func (g *graphqlCapture) Stop() {
+	g.cancel()
+}
+
+// GraphQL timeline response types (subset of fields)
+
+type gqlInstruction struct {
+	Type    string     `json:"type"`
+	Entries []gqlEntry `json:"entries"`
+	Entry   *gqlEntry  `json:"entry"`
+}
+
+type gqlEntry struct {
+	EntryID string `json:"entryId"`
+	Content struct {
+		ItemContent *gqlItemContent `json:"itemContent"`
+		Items       []struct {
+			Item struct {
+				ItemContent *gqlItemContent `json:"itemContent"`
+			} `json:"item"`
+		} `json:"items"`
+	} `json:"content"`
+}
+
+type gqlItemContent struct {
+	ItemType     string `json:"itemType"`
+	TweetResults struct {
+		Result *gqlTweetResult `json:"result"`
+	} `json:"tweet_results"`
+	PromotedMetadata json.RawMessage `json:"promotedMetadata"`
+}
+
+type gqlTweetResult struct {
+	Typename string          `json:"__typename"`
+	RestID   string          `json:"rest_id"`
+	Tweet    *gqlTweetResult `json:"tweet"` // TweetWithVisibilityResults wrapper
+	Core     struct {
+		UserResults struct {
+			Result *gqlUser `json:"result"`
+		} `json:"user_results"`
+	} `json:"core"`
+	Legacy gqlTweetLegacy `json:"legacy"`
+	Views  struct {
+		Count string `json:"count"`
+	} `json:"views"`
+	NoteTweet struct {
+		NoteTweetResults struct {
+			Result struct {
+				Text string `json:"text"`
+			} `json:"result"`
+		} `json:"note_tweet_results"`
+	} `json:"note_tweet"`
+}
+
+type gqlTweetLegacy struct {
+	IDStr                 string `json:"id_str"`
+	FullText              string `json:"full_text"`
+	CreatedAt             string `json:"created_at"`
+	Lang                  string `json:"lang"`
+	ConversationIDStr     string `json:"conversation_id_str"`
+	InReplyToStatusIDStr  string `json:"in_reply_to_status_id_str"`
+	FavoriteCount         int    `json:"favorite_count"`
+	RetweetCount          int    `json:"retweet_count"`
+	ReplyCount            int    `json:"reply_count"`
+	QuoteCount            int    `json:"quote_count"`
+	RetweetedStatusResult *struct {
+		Result *gqlTweetResult `json:"result"`
+	} `json:"retweeted_status_result"`
+}
+
+type gqlUser struct {
+	RestID         string `json:"rest_id"`
+	IsBlueVerified bool   `json:"is_blue_verified"`
+	Core           *struct {
+		Name       string `json:"name"`
+		ScreenName string `json:"screen_name"`
+		CreatedAt  string `json:"created_at"`
+	} `json:"core"`
+	Legacy struct {
+		Name                string `json:"name"`
+		ScreenName          string `json:"screen_name"`
+		Description         string `json:"description"`
+		CreatedAt           string `json:"created_at"`
+		FollowersCount      int    `json:"followers_count"`
+		FriendsCount        int    `json:"friends_count"`
+		StatusesCount       int    `json:"statuses_count"`
+		Verified            bool   `json:"verified"`
+		DefaultProfileImage bool   `json:"default_profile_image"`
+	} `json:"legacy"`
+}
+
+// parseGraphQLTweets extracts the tweets of a timeline response (SearchTimeline,
+// TweetDetail and similar) in timeline order. Promoted tweets are skipped.
+func parseGraphQLTweets(body []byte) ([]domain.Tweet, error) {
+	var root interface{}
+	if err := json.Unmarshal(body, &root); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	// The instructions array sits at a different path for each operation
+	raw := findJSONKey(root, "instructions")
+	if raw == nil {
+		if m, ok := root.(map[string]interface{}); ok && m["errors"] != nil {
+			return nil, fmt.Errorf("GraphQL error response")
+		}
+		return nil, nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var instructions []gqlInstruction
+	if err := json.Unmarshal(data, &instructions); err != nil {
+		return nil, fmt.Errorf("unexpected instructions format: %w", err)
+	}
+
+	var items []*gqlItemContent
+	addEntry := func(e *gqlEntry) {
+		if e.Content.ItemContent != nil {
+			items = append(items, e.Content.ItemContent)
+		}
+		for _, it := range e.Content.Items {
+			if it.Item.ItemContent != nil {
+				items = append(items, it.Item.ItemContent)
+			}
+		}
+	}
+	for _, ins := range instructions {
+		if ins.Entry != nil {
+			addEntry(ins.Entry)
+		}
+		for i := range ins.Entries {
+			addEntry(&ins.Entries[i])
+		}
+	}
+
+	now := time.Now()
+	var tweets []domain.Tweet
+	for _, item := range items {
+		if len(item.PromotedMetadata) > 0 && string(item.PromotedMetadata) != "null" {
+			continue
+		}
+		if tweet, ok := gqlToDomainTweet(item.TweetResults.Result, now); ok {
+			tweets = append(tweets, tweet)
+		}
+	}
+	return tweets, nil
+}
+
+// gqlToDomainTweet converts a GraphQL tweet result, unwrapping visibility
+// wrappers and retweets to the original tweet
+func gqlToDomainTweet(r *gqlTweetResult, discoveredAt time.Time) (domain.Tweet, bool) {
+	for r != nil && r.Tweet != nil {
+		r = r.Tweet
+	}
+	if r == nil || (r.Typename != "" && r.Typename != "Tweet") {
+		return domain.Tweet{}, false // TweetTombstone, TweetUnavailable, ...
+	}
+	if rt := r.Legacy.RetweetedStatusResult; rt != nil && rt.Result != nil {
+		return gqlToDomainTweet(rt.Result, discoveredAt)
+	}
+
+	id := r.RestID
+	if id == "" {
+		id = r.Legacy.IDStr
+	}
+	if id == "" {
+		return domain.Tweet{}, false
+	}
+
+	text := r.Legacy.FullText
+	if note := r.NoteTweet.NoteTweetResults.Result.Text; note != "" {
+		text = note // Long posts are truncated in legacy.full_text
+	}
+
+	tweet := domain.Tweet{
+		ID:             id,
+		Text:           html.UnescapeString(text),
+		Language:       r.Legacy.Lang,
+		LikeCount:      r.Legacy.FavoriteCount,
+		RetweetCount:   r.Legacy.RetweetCount,
+		ReplyCount:     r.Legacy.ReplyCount,
+		ConversationID: r.Legacy.ConversationIDStr,
+		InReplyToID:    r.Legacy.InReplyToStatusIDStr,
+		DiscoveredAt:   discoveredAt,
+		CreatedAt:      discoveredAt,
+	}
+	if views, err := strconv.Atoi(r.Views.Count); err == nil {
+		tweet.ViewCount = views
+	}
+	if created, err := time.Parse(time.RubyDate, r.Legacy.CreatedAt); err == nil {
+		tweet.CreatedAt = created
+	}
+
+	if u := r.Core.UserResults.Result; u != nil {
+		tweet.AuthorID = u.RestID
+		tweet.AuthorUsername = u.Legacy.ScreenName
+		tweet.AuthorName = u.Legacy.Name
+		tweet.AuthorBio = html.UnescapeString(u.Legacy.Description)
+		tweet.AuthorFollowers = u.Legacy.FollowersCount
+		tweet.AuthorFollowing = u.Legacy.FriendsCount
+		tweet.AuthorTweetCount = u.Legacy.StatusesCount
+		tweet.AuthorVerified = u.Legacy.Verified || u.IsBlueVerified
+		tweet.AuthorDefaultImage = u.Legacy.DefaultProfileImage
+		createdAt := u.Legacy.CreatedAt
+		if u.Core != nil && u.Core.CreatedAt != "" {
+			createdAt = u.Core.CreatedAt
+		}
+		if created, err := time.Parse(time.RubyDate, createdAt); err == nil {
+			tweet.AuthorCreatedAt = &created
+		}
+		if u.Core != nil {
+			// Newer responses moved the names out of legacy
+			if u.Core.ScreenName != "" {
+				tweet.AuthorUsername = u.Core.ScreenName
+			}
+			if u.Core.Name != "" {
+				tweet.AuthorName = u.Core.Name
+			}
+		}
+	}
+
+	return tweet, true
+}
+
+// findJSONKey returns a value stored under key anywhere in a decoded JSON tree
+// (timeline responses hold a single instructions array)
+func findJSONKey(v interface{}, key string) interface{} {
+	switch node := v.(type) {
+	case map[string]interface{}:
+		if found, ok := node[key]; ok {
+			return found
+		}
+		for _, child := range node {
+			if found := findJSONKey(child, key); found != nil {
+				return found
+			}
+		}
+	case []interface{}:
+		for _, child := range node {
+			if found := findJSONKey(child, key); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
+// gqlCreateTweetResponse is the CreateTweet mutation response (subset of fields)
+type gqlCreateTweetResponse struct {
+	Data struct {
+		CreateTweet struct {
+			TweetResults struct {
+				Result *gqlTweetResult `json:"result"`
+			} `json:"tweet_results"`
+		} `json:"create_tweet"`
+	} `json:"data"`
+	Errors []struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// parseCreateTweet returns the ID of the tweet a CreateTweet response created,
+// or the error it reports
+func parseCreateTweet(body []byte) (string, error) {
+	var resp gqlCreateTweetResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return "", fmt.Errorf("invalid CreateTweet response: %w", err)
+	}
+
+	if len(resp.Errors) > 0 {
+		e := resp.Errors[0]
+		return "", fmt.Errorf("%w (%d: %s)", classifyPostError(e.Code), e.Code, e.Message)
+	}
+
+	result := resp.Data.CreateTweet.TweetResults.Result
+	if result != nil && result.Tweet != nil {
+		result = result.Tweet
+	}
+	if result == nil || result.RestID == "" {
+		return "", domain.ErrPostUnconfirmed
+	}
+	return result.RestID, nil
+}
+
+// gqlDeleteTweetResponse is the DeleteTweet mutation response (subset of fields)
+type gqlDeleteTweetResponse struct {
+	Data struct {
+		DeleteTweet *struct{} `json:"delete_tweet"`
+	} `json:"data"`
+	Errors []struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// parseDeleteTweet returns the error a DeleteTweet response reports, if any
+func parseDeleteTweet(body []byte) error {
+	var resp gqlDeleteTweetResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return fmt.Errorf("invalid DeleteTweet response: %w", err)
+	}
+
+	if len(resp.Errors) > 0 {
+		e := resp.Errors[0]
+		return fmt.Errorf("%w (%d: %s)", classifyPostError(e.Code), e.Code, e.Message)
+	}
+	if resp.Data.DeleteTweet == nil {
+		return domain.ErrDeleteUnconfirmed
+	}
+	return nil
+}
+
+// classifyPostError maps X API error codes returned when posting to domain errors
+func classifyPostError(code int) error {
+	switch code {
+	case 88, 185, 344:
+		return domain.ErrRateLimited
+	case 187:
+		return domain.ErrDuplicatePost
+	case 186:
+		return domain.ErrReplyTooLong
+	case 433:
+		return domain.ErrReplyRestricted
+	case 144, 385:
+		return domain.ErrTweetNotFound
+	case 64, 326:
+		return domain.ErrAccountLocked
+	case 226:
+		return domain.ErrPostRejected
+	case 32, 89:
+		return domain.ErrCookiesExpired
+	default:
+		return domain.ErrReplyFailed
+	}
+}
@@ -0,0 +1,168 @@
+package twitter
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"xtools/internal/domain"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	return body
+}
+
+func TestParseGraphQLTweetsSearchTimeline(t *testing.T) {
+	tweets, err := parseGraphQLTweets(readFixture(t, "search_timeline.json"))
+	if err != nil {
+		t.Fatalf("parseGraphQLTweets: %v", err)
+	}
+
+	// The promoted entry, the tombstone and the cursors are skipped
+	wantIDs := []string{"1846987139428634858", "1846950012345678901", "1846800000000000000"}
+	if len(tweets) != len(wantIDs) {
+		t.Fatalf("got %d tweets, want %d", len(tweets), len(wantIDs))
+	}
+	for i, id := range wantIDs {
+		if tweets[i].ID != id {
+			t.Errorf("tweet %d: ID = %q, want %q", i, tweets[i].ID, id)
+		}
+	}
+
+	t.Run("fields", func(t *testing.T) {
+		tw := tweets[0]
+		wantText := "Go 1.24 generic type aliases are here & they make refactors across packages much easier. Full write-up below, with examples of moving a type without breaking every importer at once."
+		if tw.Text != wantText {
+			t.Errorf("Text = %q, want the unescaped note_tweet text", tw.Text)
+		}
+		if want := time.Date(2024, 10, 17, 18, 2, 11, 0, time.UTC); !tw.CreatedAt.Equal(want) {
+			t.Errorf("CreatedAt = %v, want %v", tw.CreatedAt, want)
+		}
+		if tw.ViewCount != 15230 {
+			t.Errorf("ViewCount = %d, want 15230", tw.ViewCount)
+		}
+		if tw.LikeCount != 342 || tw.RetweetCount != 57 || tw.ReplyCount != 21 {
+			t.Errorf("metrics = %d/%d/%d, want 342/57/21", tw.LikeCount, tw.RetweetCount, tw.ReplyCount)
+		}
+		if tw.Language != "en" || tw.ConversationID != "1846987139428634858" {
+			t.Errorf("Language/ConversationID = %q/%q", tw.Language, tw.ConversationID)
+		}
+
+		// Names come from the newer user core block
+		if tw.AuthorID != "2244994945" || tw.AuthorUsername != "golangdev" || tw.AuthorName != "Go Developers" {
+			t.Errorf("author = %q @%q %q", tw.AuthorID, tw.AuthorUsername, tw.AuthorName)
+		}
+		if tw.AuthorBio != "Tips & news about Go" {
+			t.Errorf("AuthorBio = %q", tw.AuthorBio)
+		}
+		if tw.AuthorFollowers != 48210 || tw.AuthorFollowing != 312 || tw.AuthorTweetCount != 9120 {
+			t.Errorf("author counts = %d/%d/%d", tw.AuthorFollowers, tw.AuthorFollowing, tw.AuthorTweetCount)
+		}
+		if !tw.AuthorVerified {
+			t.Error("AuthorVerified = false, want true for a blue-verified user")
+		}
+		if tw.AuthorCreatedAt == nil || tw.AuthorCreatedAt.Year() != 2013 {
+			t.Errorf("AuthorCreatedAt = %v, want 2013", tw.AuthorCreatedAt)
+		}
+	})
+
+	t.Run("visibility wrapper", func(t *testing.T) {
+		tw := tweets[1]
+		if tw.AuthorUsername != "analima" || tw.AuthorName != "Ana Lima" {
+			t.Errorf("author = @%q %q", tw.AuthorUsername, tw.AuthorName)
+		}
+		if tw.InReplyToID != "1846900000000000000" {
+			t.Errorf("InReplyToID = %q", tw.InReplyToID)
+		}
+		if !tw.AuthorDefaultImage {
+			t.Error("AuthorDefaultImage = false, want true")
+		}
+		if tw.ViewCount != 0 {
+			t.Errorf("ViewCount = %d, want 0 without a count", tw.ViewCount)
+		}
+	})
+
+	t.Run("retweet", func(t *testing.T) {
+		tw := tweets[2]
+		if tw.AuthorUsername != "gopherweekly" {
+			t.Errorf("AuthorUsername = %q, want the original author", tw.AuthorUsername)
+		}
+		if tw.Text != "Issue #512 is out: profiling, iterators and more" {
+			t.Errorf("Text = %q, want the original text", tw.Text)
+		}
+		if tw.LikeCount != 910 || tw.ViewCount != 98000 {
+			t.Errorf("metrics = %d likes, %d views, want the original's", tw.LikeCount, tw.ViewCount)
+		}
+	})
+}
+
+func TestParseGraphQLTweetsTweetDetail(t *testing.T) {
+	tweets, err := parseGraphQLTweets(readFixture(t, "tweet_detail.json"))
+	if err != nil {
+		t.Fatalf("parseGraphQLTweets: %v", err)
+	}
+
+	// Module items (conversation threads) are flattened in order
+	want := []struct{ id, inReplyTo string }{
+		{"1846900000000000000", ""},
+		{"1846950012345678901", "1846900000000000000"},
+		{"1846960000000000000", "1846950012345678901"},
+	}
+	if len(tweets) != len(want) {
+		t.Fatalf("got %d tweets, want %d", len(tweets), len(want))
+	}
+	for i, w := range want {
+		if tweets[i].ID != w.id || tweets[i].InReplyToID != w.inReplyTo {
+			t.Errorf("tweet %d = %q replying to %q, want %q replying to %q", i, tweets[i].ID, tweets[i].InReplyToID, w.id, w.inReplyTo)
+		}
+		if tweets[i].ConversationID != "1846900000000000000" {
+			t.Errorf("tweet %d: ConversationID = %q", i, tweets[i].ConversationID)
+		}
+	}
+}
+
+func TestParseGraphQLTweetsErrorResponse(t *testing.T) {
+	body := readFixture(t, "graphql_error.json")
+	if _, err := parseGraphQLTweets(body); err == nil {
+		t.Fatal("parseGraphQLTweets: want an error for an error response")
+	}
+
+	// No tweets from the capture makes SearchTweets fall back to the DOM
+	capture := &graphqlCapture{bodies: [][]byte{body}}
+	if tweets := capture.Tweets(); len(tweets) != 0 {
+		t.Errorf("capture.Tweets() = %d tweets, want none", len(tweets))
+	}
+}
+
+func TestGraphQLCaptureTweetsDeduplicates(t *testing.T) {
+	search := readFixture(t, "search_timeline.json")
+	capture := &graphqlCapture{bodies: [][]byte{search, readFixture(t, "graphql_error.json"), search}}
+	if tweets := capture.Tweets(); len(tweets) != 3 {
+		t.Errorf("capture.Tweets() = %d tweets, want 3", len(tweets))
+	}
+}
+
+func TestParseCreateTweet(t *testing.T) {
+	id, err := parseCreateTweet(readFixture(t, "create_tweet.json"))
+	if err != nil {
+		t.Fatalf("parseCreateTweet: %v", err)
+	}
+	if id != "1847001234567890123" {
+		t.Errorf("ID = %q, want 1847001234567890123", id)
+	}
+
+	if _, err := parseCreateTweet(readFixture(t, "create_tweet_duplicate.json")); !errors.Is(err, domain.ErrDuplicatePost) {
+		t.Errorf("duplicate: err = %v, want ErrDuplicatePost", err)
+	}
+
+	if _, err := parseCreateTweet([]byte(`{"data":{"create_tweet":{"tweet_results":{}}}}`)); !errors.Is(err, domain.ErrPostUnconfirmed) {
+		t.Errorf("empty result: err = %v, want ErrPostUnconfirmed", err)
+	}
+}
@@ -0,0 +1,274 @@
+package twitter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"xtools/internal/domain"
+)
+
+const (
+	// v1.1 media endpoints (chunked upload is not available in v2)
+	mediaBaseURL = "https://upload.twitter.com/1.1/media"
+
+	mediaChunkSize    = 1024 * 1024 // APPEND segments may be up to 5MB
+	maxImageSize      = 5 * 1024 * 1024
+	maxGIFSize        = 15 * 1024 * 1024
+	maxImagesPerTweet = 4
+)
+
+// Supported media types and their upload categories
+var mediaCategories = map[string]string{
+	"image/jpeg": "tweet_image",
+	"image/png":  "tweet_image",
+	"image/webp": "tweet_image",
+	"image/gif":  "tweet_gif",
+}
+
+// ValidateMedia checks that attachments exist, are supported images or GIFs
+// and fit in a single tweet (up to 4 images, or 1 GIF)
+func ValidateMedia(media []domain.MediaAttachment) error {
+	if len(media) == 0 {
+		return nil
+	}
+	if len(media) > maxImagesPerTweet {
+		return fmt.Errorf("at most %d images can be attached", maxImagesPerTweet)
+	}
+
+	for _, m := range media {
+		info, err := os.Stat(m.Path)
+		if err != nil {
+			return fmt.Errorf("media file %s: %w", filepath.Base(m.Path), err)
+		}
+
+		mimeType, err := detectMediaType(m)
+		if err != nil {
+			return err
+		}
+
+		limit := int64(maxImageSize)
+		if mimeType == "image/gif" {
+			if len(media) > 1 {
+				return fmt.Errorf("a GIF must be the only attachment")
+			}
+			limit = maxGIFSize
+		}
+		if info.Size() > limit {
+			return fmt.Errorf("media file %s is too large (%d MB max)", filepath.Base(m.Path), limit/(1024*1024))
+		}
+	}
+
+	return nil
+}
+
+// detectMediaType returns the attachment's MIME type, sniffing the file when not set
+func detectMediaType(m domain.MediaAttachment) (string, error) {
+	mimeType := m.MimeType
+	if mimeType == "" {
+		f, err := os.Open(m.Path)
+		if err != nil {
+			return "", fmt.Errorf("media file %s: %w", filepath.Base(m.Path), err)
+		}
+		defer f.Close()
+
+		head := make([]byte, 512)
+		n, _ := io.ReadFull(f, head)
+		mimeType = http.DetectContentType(head[:n])
+	}
+
+	if _, ok := mediaCategories[mimeType]; !ok {
+		return "", fmt.Errorf("media file %s: unsupported type %s (JPEG, PNG, WEBP or GIF only)", filepath.Base(m.Path), mimeType)
+	}
+	return mimeType, nil
+}
+
+// uploadAll uploads attachments and returns their media IDs
+func (c *APIClient) uploadAll(ctx context.Context, media []domain.MediaAttachment) ([]string, error) {
+	if len(media) == 0 {
+		return nil, nil
+	}
+	if err := ValidateMedia(media); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(media))
+	for _, m := range media {
+		id, err := c.UploadMedia(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// UploadMedia uploads an image or GIF with the chunked INIT/APPEND/FINALIZE flow
+// and returns its media ID
+func (c *APIClient) UploadMedia(ctx context.Context, m domain.MediaAttachment) (string, error) {
+	if !hasOAuthCredentials(c.credentials) {
+		return "", fmt.Errorf("OAuth credentials required for media upload")
+	}
+
+	mimeType, err := detectMediaType(m)
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(m.Path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read media file: %w", err)
+	}
+
+	// INIT
+	params := url.Values{}
+	params.Set("command", "INIT")
+	params.Set("total_bytes", strconv.Itoa(len(data)))
+	params.Set("media_type", mimeType)
+	params.Set("media_category", mediaCategories[mimeType])
+
+	var initResp MediaUploadResponse
+	if err := c.mediaCommand(ctx, "POST", params, &initResp); err != nil {
+		return "", fmt.Errorf("media upload init failed: %w", err)
+	}
+	mediaID := initResp.MediaIDString
+	if mediaID == "" {
+		return "", fmt.Errorf("media upload init failed: no media ID returned")
+	}
+
+	// APPEND
+	for segment, offset := 0, 0; offset < len(data); segment, offset = segment+1, offset+mediaChunkSize {
+		end := min(offset+mediaChunkSize, len(data))
+		if err := c.appendMediaChunk(ctx, mediaID, segment, data[offset:end]); err != nil {
+			return "", fmt.Errorf("media upload append failed: %w", err)
+		}
+	}
+
+	// FINALIZE
+	params = url.Values{}
+	params.Set("command", "FINALIZE")
+	params.Set("media_id", mediaID)
+
+	var finalResp MediaUploadResponse
+	if err := c.mediaCommand(ctx, "POST", params, &finalResp); err != nil {
+		return "", fmt.Errorf("media upload finalize failed: %w", err)
+	}
+
+	// GIFs are processed asynchronously; poll STATUS until done
+	if err := c.waitForMediaProcessing(ctx, mediaID, finalResp.ProcessingInfo); err != nil {
+		return "", err
+	}
+
+	if m.AltText != "" {
+		if err := c.setMediaAltText(ctx, mediaID, m.AltText); err != nil {
+			return "", fmt.Errorf("failed to set media alt text: %w", err)
+		}
+	}
+
+	fmt.Printf("[Twitter API] Uploaded media %s (%s, %d bytes)\n", mediaID, mimeType, len(data))
+	return mediaID, nil
+}
+
+// waitForMediaProcessing polls the STATUS command until processing succeeds or fails
+func (c *APIClient) waitForMediaProcessing(ctx context.Context, mediaID string, info *MediaProcessingInfo) error {
+	for info != nil {
+		switch info.State {
+		case "succeeded":
+			return nil
+		case "failed":
+			msg := "unknown error"
+			if info.Error != nil {
+				msg = info.Error.Message
+			}
+			return fmt.Errorf("media processing failed: %s", msg)
+		}
+
+		wait := time.Duration(max(info.CheckAfterSecs, 1)) * time.Second
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+
+		params := url.Values{}
+		params.Set("command", "STATUS")
+		params.Set("media_id", mediaID)
+
+		var statusResp MediaUploadResponse
+		if err := c.mediaCommand(ctx, "GET", params, &statusResp); err != nil {
+			return fmt.Errorf("media status check failed: %w", err)
+		}
+		info = statusResp.ProcessingInfo
+	}
+	return nil
+}
+
+// mediaCommand sends an upload command with its parameters in the query string
+// and decodes the JSON response into out
+func (c *APIClient) mediaCommand(ctx context.Context, method string, params url.Values, out interface{}) error {
+	resp, err := c.doSignedRequest(ctx, method, c.mediaURL+"/upload.json?"+params.Encode(), nil, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil && err != io.EOF {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// appendMediaChunk uploads one segment as multipart form data
+func (c *APIClient) appendMediaChunk(ctx context.Context, mediaID string, segment int, chunk []byte) error {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("media", "blob")
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(chunk); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	params := url.Values{}
+	params.Set("command", "APPEND")
+	params.Set("media_id", mediaID)
+	params.Set("segment_index", strconv.Itoa(segment))
+
+	resp, err := c.doSignedRequest(ctx, "POST", c.mediaURL+"/upload.json?"+params.Encode(), &body, w.FormDataContentType())
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// setMediaAltText attaches alt text to an uploaded image
+func (c *APIClient) setMediaAltText(ctx context.Context, mediaID, altText string) error {
+	body, err := json.Marshal(MediaMetadataRequest{
+		MediaID: mediaID,
+		AltText: MediaAltText{Text: strings.TrimSpace(altText)},
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.doSignedRequest(ctx, "POST", c.mediaURL+"/metadata/create.json", bytes.NewReader(body), "application/json")
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
@@ -0,0 +1,199 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"xtools/internal/domain"
+)
+
+// mediaStub is a local stand-in for the v1.1 media upload endpoints
+type mediaStub struct {
+	t *testing.T
+
+	mu       sync.Mutex
+	commands []string                                         // Commands in the order received
+	segments map[int]int                                      // APPEND segment index -> bytes
+	altText  string                                           // Last metadata/create alt text
+	handle   func(command string, w http.ResponseWriter) bool // Overrides a response when it returns true
+}
+
+func newMediaStub(t *testing.T) (*mediaStub, *APIClient) {
+	stub := &mediaStub{t: t, segments: make(map[int]int)}
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+
+	client, err := NewAPIClient(domain.APICredentials{
+		BearerToken:  "bearer",
+		APIKey:       "key",
+		APISecret:    "secret",
+		AccessToken:  "token",
+		AccessSecret: "token-secret",
+	})
+	if err != nil {
+		t.Fatalf("NewAPIClient: %v", err)
+	}
+	client.mediaURL = server.URL + "/1.1/media"
+	return stub, client
+}
+
+func (s *mediaStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "OAuth ") {
+		s.t.Errorf("%s %s: request is not OAuth signed", r.Method, r.URL.Path)
+	}
+
+	if r.URL.Path == "/1.1/media/metadata/create.json" {
+		var req MediaMetadataRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		s.mu.Lock()
+		s.altText = req.AltText.Text
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	command := r.URL.Query().Get("command")
+	s.mu.Lock()
+	s.commands = append(s.commands, command)
+	handle := s.handle
+	s.mu.Unlock()
+
+	if handle != nil && handle(command, w) {
+		return
+	}
+
+	switch command {
+	case "INIT":
+		fmt.Fprint(w, `{"media_id_string":"710511363345354753"}`)
+	case "APPEND":
+		if r.Method != http.MethodPost {
+			s.t.Errorf("APPEND method = %s, want POST", r.Method)
+		}
+		file, _, err := r.FormFile("media")
+		if err != nil {
+			s.t.Errorf("APPEND without a media part: %v", err)
+			return
+		}
+		data, _ := io.ReadAll(file)
+		var segment int
+		fmt.Sscan(r.URL.Query().Get("segment_index"), &segment)
+		s.mu.Lock()
+		s.segments[segment] = len(data)
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	case "FINALIZE":
+		fmt.Fprint(w, `{"media_id_string":"710511363345354753"}`)
+	default:
+		s.t.Errorf("unexpected command %q", command)
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func (s *mediaStub) commandList() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return strings.Join(s.commands, ",")
+}
+
+func writeMediaFile(t *testing.T, name string, size int) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, make([]byte, size), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestUploadMediaChunked(t *testing.T) {
+	stub, client := newMediaStub(t)
+	size := mediaChunkSize + mediaChunkSize/2
+	path := writeMediaFile(t, "photo.png", size)
+
+	id, err := client.UploadMedia(context.Background(), domain.MediaAttachment{Path: path, MimeType: "image/png", AltText: " A gopher "})
+	if err != nil {
+		t.Fatalf("UploadMedia: %v", err)
+	}
+	if id != "710511363345354753" {
+		t.Errorf("media ID = %q", id)
+	}
+
+	if got := stub.commandList(); got != "INIT,APPEND,APPEND,FINALIZE" {
+		t.Errorf("commands = %s, want INIT,APPEND,APPEND,FINALIZE", got)
+	}
+	if stub.segments[0] != mediaChunkSize || stub.segments[1] != size-mediaChunkSize {
+		t.Errorf("segments = %v, want %d and %d bytes", stub.segments, mediaChunkSize, size-mediaChunkSize)
+	}
+	if stub.altText != "A gopher" {
+		t.Errorf("alt text = %q, want trimmed %q", stub.altText, "A gopher")
+	}
+}
+
+func TestUploadMediaGIFProcessing(t *testing.T) {
+	stub, client := newMediaStub(t)
+	stub.handle = func(command string, w http.ResponseWriter) bool {
+		switch command {
+		case "FINALIZE":
+			fmt.Fprint(w, `{"media_id_string":"710511363345354753","processing_info":{"state":"pending","check_after_secs":1}}`)
+			return true
+		case "STATUS":
+			fmt.Fprint(w, `{"media_id_string":"710511363345354753","processing_info":{"state":"succeeded"}}`)
+			return true
+		}
+		return false
+	}
+	path := writeMediaFile(t, "clip.gif", 2048)
+
+	if _, err := client.UploadMedia(context.Background(), domain.MediaAttachment{Path: path, MimeType: "image/gif"}); err != nil {
+		t.Fatalf("UploadMedia: %v", err)
+	}
+	if got := stub.commandList(); got != "INIT,APPEND,FINALIZE,STATUS" {
+		t.Errorf("commands = %s, want INIT,APPEND,FINALIZE,STATUS", got)
+	}
+}
+
+func TestUploadMediaGIFProcessingFailed(t *testing.T) {
+	stub, client := newMediaStub(t)
+	stub.handle = func(command string, w http.ResponseWriter) bool {
+		if command == "FINALIZE" {
+			fmt.Fprint(w, `{"media_id_string":"710511363345354753","processing_info":{"state":"failed","error":{"message":"InvalidMedia"}}}`)
+			return true
+		}
+		return false
+	}
+	path := writeMediaFile(t, "clip.gif", 2048)
+
+	_, err := client.UploadMedia(context.Background(), domain.MediaAttachment{Path: path, MimeType: "image/gif"})
+	if err == nil || !strings.Contains(err.Error(), "InvalidMedia") {
+		t.Errorf("err = %v, want the processing error", err)
+	}
+}
+
+func TestUploadMediaRateLimited(t *testing.T) {
+	stub, client := newMediaStub(t)
+	stub.handle = func(command string, w http.ResponseWriter) bool {
+		if command == "APPEND" {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return true
+		}
+		return false
+	}
+	path := writeMediaFile(t, "photo.png", 1024)
+
+	_, err := client.UploadMedia(context.Background(), domain.MediaAttachment{Path: path, MimeType: "image/png"})
+	if !errors.Is(err, domain.ErrRateLimited) {
+		t.Errorf("err = %v, want ErrRateLimited", err)
+	}
+	if got := stub.commandList(); got != "INIT,APPEND" {
+		t.Errorf("commands = %s, want the upload to stop at APPEND", got)
+	}
+}
@@ -0,0 +1,92 @@
+package twitter
+
+import (
+	"strings"
+
+	"xtools/internal/domain"
+)
+
+// MaxAPIQueryLength is the longest query the v2 recent search endpoint accepts
+const MaxAPIQueryLength = 512
+
+// queryOperators holds the syntax that differs between the API and web search
+type queryOperators struct {
+	verified string
+	media    string
+	links    string
+	dates    bool // since:/until: are part of the query
+}
+
+var (
+	apiOperators = queryOperators{verified: "is:verified", media: "has:media", links: "has:links"}
+	webOperators = queryOperators{verified: "filter:verified", media: "filter:media", links: "filter:links", dates: true}
+)
+
+// BuildAPIQuery renders a query in API v2 syntax. The date range is not part of
+// it; pass it as SearchOptions.StartTime and EndTime.
+func BuildAPIQuery(q domain.SearchQuery) string {
+	return buildQuery(q, apiOperators)
+}
+
+// BuildWebQuery renders a query in x.com search syntax
+func BuildWebQuery(q domain.SearchQuery) string {
+	return buildQuery(q, webOperators)
+}
+
+func buildQuery(q domain.SearchQuery, ops queryOperators) string {
+	var parts []string
+	addAny := func(terms []string) {
+		switch len(terms) {
+		case 0:
+		case 1:
+			if strings.Contains(terms[0], " OR ") {
+				parts = append(parts, "("+terms[0]+")")
+			} else {
+				parts = append(parts, terms[0])
+			}
+		default:
+			parts = append(parts, "("+strings.Join(terms, " OR ")+")")
+		}
+	}
+	prefixed := func(prefix string, values []string, trim string) []string {
+		out := make([]string, 0, len(values))
+		for _, v := range values {
+			out = append(out, prefix+strings.TrimPrefix(strings.TrimSpace(v), trim))
+		}
+		return out
+	}
+
+	addAny(q.AnyWords)
+	parts = append(parts, q.AllWords...)
+	for _, phrase := range q.ExactPhrases {
+		parts = append(parts, `"`+phrase+`"`)
+	}
+	addAny(prefixed("from:", q.FromUsers, "@"))
+	addAny(prefixed("to:", q.ToUsers, "@"))
+	addAny(prefixed("@", q.Mentions, "@"))
+	addAny(prefixed("#", q.Hashtags, "#"))
+	parts = append(parts, prefixed("-", q.NoneWords, "")...)
+
+	if q.Lang != "" {
+		parts = append(parts, "lang:"+q.Lang)
+	}
+	if q.HasMedia {
+		parts = append(parts, ops.media)
+	}
+	if q.HasLinks {
+		parts = append(parts, ops.links)
+	}
+	if q.VerifiedOnly {
+		parts = append(parts, ops.verified)
+	}
+	if ops.dates {
+		if q.Since != "" {
+			parts = append(parts, "since:"+q.Since)
+		}
+		if q.Until != "" {
+			parts = append(parts, "until:"+q.Until)
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
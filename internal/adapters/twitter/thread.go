@@ -0,0 +1,63 @@
+package twitter
+
+import (
+	"sort"
+
+	"xtools/internal/domain"
+)
+
+// Thread retrieval limits shared by both clients
+const (
+	maxThreadParents = 10 // Ancestors walked up from the tweet
+	maxThreadReplies = 10 // Top replies kept below the tweet
+)
+
+// buildThread orders a conversation as the tweet's parent chain (oldest first),
+// the tweet itself, then its most liked direct replies. Parents missing from the
+// conversation are resolved with lookup, which may return nil.
+func buildThread(tweet domain.Tweet, conversation []domain.Tweet, lookup func(id string) *domain.Tweet) []domain.Tweet {
+	byID := make(map[string]domain.Tweet, len(conversation))
+	for _, t := range conversation {
+		byID[t.ID] = t
+	}
+
+	// Walk up the reply chain
+	var parents []domain.Tweet
+	seen := map[string]bool{tweet.ID: true}
+	for parentID := tweet.InReplyToID; parentID != "" && len(parents) < maxThreadParents && !seen[parentID]; {
+		seen[parentID] = true
+
+		parent, ok := byID[parentID]
+		if !ok {
+			p := lookup(parentID)
+			if p == nil {
+				break
+			}
+			parent = *p
+		}
+		parents = append(parents, parent)
+		parentID = parent.InReplyToID
+	}
+
+	thread := make([]domain.Tweet, 0, len(parents)+1+maxThreadReplies)
+	for i := len(parents) - 1; i >= 0; i-- {
+		thread = append(thread, parents[i])
+	}
+	thread = append(thread, tweet)
+
+	// Direct replies, most liked first
+	var replies []domain.Tweet
+	for _, t := range conversation {
+		if t.InReplyToID == tweet.ID && !seen[t.ID] {
+			replies = append(replies, t)
+		}
+	}
+	sort.SliceStable(replies, func(i, j int) bool {
+		return replies[i].LikeCount > replies[j].LikeCount
+	})
+	if len(replies) > maxThreadReplies {
+		replies = replies[:maxThreadReplies]
+	}
+
+	return append(thread, replies...)
+}
@@ -1,6 +1,7 @@
 package twitter
 
 import (
+	"strings"
 	"time"
 	"xtools/internal/domain"
 )
@@ -56,6 +57,9 @@ type UserData struct {
 	Username        string        `json:"username"`
 	Description     string        `json:"description,omitempty"`
 	Verified        bool          `json:"verified,omitempty"`
+	VerifiedType    string        `json:"verified_type,omitempty"` // "blue", "business", "government" or "none"
+	CreatedAt       string        `json:"created_at,omitempty"`
+	ProfileImageURL string        `json:"profile_image_url,omitempty"`
 	PublicMetrics   *UserMetrics  `json:"public_metrics,omitempty"`
 }
 
@@ -85,8 +89,15 @@ type APIError struct {
 
 // CreateTweetRequest for posting tweets/replies
 type CreateTweetRequest struct {
-	Text  string        `json:"text"`
-	Reply *ReplyOptions `json:"reply,omitempty"`
+	Text         string        `json:"text"`
+	Reply        *ReplyOptions `json:"reply,omitempty"`
+	QuoteTweetID string        `json:"quote_tweet_id,omitempty"`
+	Media        *MediaOptions `json:"media,omitempty"`
+}
+
+// MediaOptions for attaching uploaded media to a tweet
+type MediaOptions struct {
+	MediaIDs []string `json:"media_ids"`
 }
 
 // ReplyOptions for replying to a tweet
@@ -106,6 +117,40 @@ type CreatedTweet struct {
 	Text string `json:"text"`
 }
 
+// DeleteTweetResponse from deleting a tweet
+type DeleteTweetResponse struct {
+	Data struct {
+		Deleted bool `json:"deleted"`
+	} `json:"data"`
+	Errors []APIError `json:"errors,omitempty"`
+}
+
+// MediaUploadResponse from the v1.1 media upload endpoint
+type MediaUploadResponse struct {
+	MediaIDString  string               `json:"media_id_string"`
+	ProcessingInfo *MediaProcessingInfo `json:"processing_info,omitempty"`
+}
+
+// MediaProcessingInfo reports async processing state (GIFs and videos)
+type MediaProcessingInfo struct {
+	State          string `json:"state"` // pending, in_progress, succeeded, failed
+	CheckAfterSecs int    `json:"check_after_secs"`
+	Error          *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// MediaMetadataRequest sets alt text on uploaded media
+type MediaMetadataRequest struct {
+	MediaID string       `json:"media_id"`
+	AltText MediaAltText `json:"alt_text"`
+}
+
+// MediaAltText is the alt text payload of a metadata request
+type MediaAltText struct {
+	Text string `json:"text"`
+}
+
 // Helper functions to convert API types to domain types
 
 // ToDomainTweet converts TweetData to domain.Tweet
@@ -138,6 +183,16 @@ func (t *TweetData) ToDomainTweet(users map[string]UserData) domain.Tweet {
 		tweet.AuthorUsername = author.Username
 		tweet.AuthorName = author.Name
 		tweet.AuthorBio = author.Description
+		tweet.AuthorVerified = author.Verified || (author.VerifiedType != "" && author.VerifiedType != "none")
+		tweet.AuthorDefaultImage = strings.Contains(author.ProfileImageURL, "default_profile_images")
+		if author.PublicMetrics != nil {
+			tweet.AuthorFollowers = author.PublicMetrics.FollowersCount
+			tweet.AuthorFollowing = author.PublicMetrics.FollowingCount
+			tweet.AuthorTweetCount = author.PublicMetrics.TweetCount
+		}
+		if created, err := time.Parse(time.RFC3339, author.CreatedAt); err == nil {
+			tweet.AuthorCreatedAt = &created
+		}
 	}
 
 	// Add reply reference
@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // AuthType defines the authentication method for Twitter
 type AuthType string
@@ -73,6 +76,24 @@ type AccountConfig struct {
 
 	// Rate Limiting
 	RateLimits RateLimits `yaml:"rate_limits" json:"rateLimits"`
+
+	// When and how spread out approved replies are posted
+	Posting PostingConfig `yaml:"posting" json:"posting"`
+
+	// Polymarket alert tweets
+	AlertBridge AlertBridgeConfig `yaml:"alert_bridge" json:"alertBridge"`
+
+	// LLM relevance screening of found tweets
+	Relevance RelevanceConfig `yaml:"relevance" json:"relevance"`
+
+	// Content checks before auto-posting
+	Guardrails GuardrailConfig `yaml:"guardrails" json:"guardrails"`
+
+	// A/B test of persona and prompt variants
+	Experiment ExperimentConfig `yaml:"experiment" json:"experiment"`
+
+	// Follow-ups to people who reply to our replies
+	FollowUps FollowUpConfig `yaml:"follow_ups" json:"followUps"`
 }
 
 // APICredentials holds Twitter API v2 credentials
@@ -123,6 +144,78 @@ type SearchConfig struct {
 	MinRetweets     int      `yaml:"min_retweets" json:"minRetweets"`
 	MaxAgeMins      int      `yaml:"max_age_mins" json:"maxAgeMins"`
 	IntervalSecs    int      `yaml:"interval_secs" json:"intervalSecs"`
+	MaxPages        int      `yaml:"max_pages" json:"maxPages"` // Result pages fetched per run (0 = 1)
+
+	// Structured operators added to every query; keywords are its any-of terms
+	Query *SearchQuery `yaml:"query,omitempty" json:"query,omitempty"`
+
+	// Author targeting: authors scoring below the minimum are skipped, allowlisted
+	// authors are never skipped by score and are handled first
+	MinAuthorScore int      `yaml:"min_author_score" json:"minAuthorScore"` // 0-100, 0 = off
+	Allowlist      []string `yaml:"allowlist,omitempty" json:"allowlist,omitempty"`
+
+	// Per-keyword mode runs each group (and each ungrouped keyword) as its own query
+	PerKeyword    bool           `yaml:"per_keyword" json:"perKeyword"`
+	KeywordGroups []KeywordGroup `yaml:"keyword_groups,omitempty" json:"keywordGroups,omitempty"`
+}
+
+// SearchFilters are the engagement and age thresholds applied to a query
+type SearchFilters struct {
+	MinFaves    int `yaml:"min_faves" json:"minFaves"`
+	MinReplies  int `yaml:"min_replies" json:"minReplies"`
+	MinRetweets int `yaml:"min_retweets" json:"minRetweets"`
+	MaxAgeMins  int `yaml:"max_age_mins" json:"maxAgeMins"`
+}
+
+// KeywordGroup is a set of keywords searched as one query in per-keyword mode
+type KeywordGroup struct {
+	Name         string         `yaml:"name" json:"name"`
+	Keywords     []string       `yaml:"keywords" json:"keywords"`
+	Filters      *SearchFilters `yaml:"filters,omitempty" json:"filters,omitempty"`            // nil uses the account filters
+	IntervalSecs int            `yaml:"interval_secs,omitempty" json:"intervalSecs,omitempty"` // 0 runs on every search
+}
+
+// Filters returns the account-wide search filters
+func (c SearchConfig) Filters() SearchFilters {
+	return SearchFilters{
+		MinFaves:    c.MinFaves,
+		MinReplies:  c.MinReplies,
+		MinRetweets: c.MinRetweets,
+		MaxAgeMins:  c.MaxAgeMins,
+	}
+}
+
+// Groups returns the queries to run in per-keyword mode: the configured groups,
+// then one group per keyword that is not part of a group
+func (c SearchConfig) Groups() []KeywordGroup {
+	grouped := make(map[string]bool)
+	groups := make([]KeywordGroup, 0, len(c.KeywordGroups)+len(c.Keywords))
+	for _, g := range c.KeywordGroups {
+		var keywords []string
+		for _, kw := range g.Keywords {
+			if kw = strings.TrimSpace(kw); kw != "" {
+				keywords = append(keywords, kw)
+			}
+		}
+		if len(keywords) == 0 {
+			continue
+		}
+		g.Keywords = keywords
+		if g.Name == "" {
+			g.Name = strings.Join(g.Keywords, " | ")
+		}
+		for _, kw := range g.Keywords {
+			grouped[strings.ToLower(kw)] = true
+		}
+		groups = append(groups, g)
+	}
+
+	for _, kw := range c.Keywords {
+		if !grouped[strings.ToLower(kw)] {
+			groups = append(groups, KeywordGroup{Name: kw, Keywords: []string{kw}})
+		}
+	}
+	return groups
 }
 
 // ReplyConfig holds reply behavior settings
@@ -133,6 +226,115 @@ type ReplyConfig struct {
 	Tone            string       `yaml:"tone" json:"tone"`
 	IncludeHashtags bool         `yaml:"include_hashtags" json:"includeHashtags"`
 	SignatureText   string       `yaml:"signature_text,omitempty" json:"signatureText,omitempty"`
+	Candidates      int          `yaml:"candidates,omitempty" json:"candidates,omitempty"`           // Queued replies offer this many variants to pick from (0-1 = one)
+	UndoWindowSecs  int          `yaml:"undo_window_secs,omitempty" json:"undoWindowSecs,omitempty"` // Auto mode: seconds a posted reply can be undone from the activity feed (0 = off)
+}
+
+// AlertBridgeConfig turns high-risk Polymarket trades into tweets from this account
+type AlertBridgeConfig struct {
+	Enabled       bool    `yaml:"enabled" json:"enabled"`
+	MinTradeValue float64 `yaml:"min_trade_value" json:"minTradeValue"`         // Minimum notional (USD) of a fresh-wallet trade
+	DailyCap      int     `yaml:"daily_cap" json:"dailyCap"`                    // Maximum alert tweets drafted per day
+	Template      string  `yaml:"template,omitempty" json:"template,omitempty"` // LLM prompt template (Go text/template)
+}
+
+// RelevanceConfig drops found tweets the LLM judges off-topic before replying
+type RelevanceConfig struct {
+	Enabled  bool    `yaml:"enabled" json:"enabled"`
+	Topic    string  `yaml:"topic" json:"topic"`                     // What the account wants to reply about
+	MinScore float64 `yaml:"min_score" json:"minScore"`              // 0-1, tweets scoring lower are dropped
+	Model    string  `yaml:"model,omitempty" json:"model,omitempty"` // Cheaper model on the same endpoint (empty = reply model)
+}
+
+// GuardrailConfig holds content checks a reply must pass to be posted without
+// review; failing replies go to the approval queue
+type GuardrailConfig struct {
+	Enabled            bool     `yaml:"enabled" json:"enabled"`
+	BannedWords        []string `yaml:"banned_words,omitempty" json:"bannedWords,omitempty"`       // Case-insensitive words or phrases
+	BannedPatterns     []string `yaml:"banned_patterns,omitempty" json:"bannedPatterns,omitempty"` // Regular expressions
+	AllowLinks         bool     `yaml:"allow_links" json:"allowLinks"`
+	AllowMentions      bool     `yaml:"allow_mentions" json:"allowMentions"`           // @mentions of anyone but the author replied to
+	MaxHashtags        int      `yaml:"max_hashtags" json:"maxHashtags"`               // 0 = no limit
+	DuplicateThreshold float64  `yaml:"duplicate_threshold" json:"duplicateThreshold"` // 0-1 word overlap with a recent reply that counts as a duplicate (0 = off)
+	LLMModeration      bool     `yaml:"llm_moderation" json:"llmModeration"`           // Also ask the LLM whether the reply is safe to post
+}
+
+// FollowUpConfig watches the account's mentions for replies to its posted
+// replies and queues follow-ups for approval
+type FollowUpConfig struct {
+	Enabled      bool `yaml:"enabled" json:"enabled"`
+	MaxDepth     int  `yaml:"max_depth" json:"maxDepth"`         // Follow-ups allowed down one conversation
+	IntervalSecs int  `yaml:"interval_secs" json:"intervalSecs"` // How often mentions are checked (0 = every 5 minutes)
+}
+
+// ExperimentConfig splits an account's replies between persona and prompt
+// variants so their engagement can be compared
+type ExperimentConfig struct {
+	Enabled  bool                `yaml:"enabled" json:"enabled"`
+	Variants []ExperimentVariant `yaml:"variants" json:"variants"`
+}
+
+// ExperimentVariant overrides the account's reply settings for a share of replies
+type ExperimentVariant struct {
+	Name         string `yaml:"name" json:"name"`
+	Weight       int    `yaml:"weight" json:"weight"`                                 // Relative share of replies
+	Persona      string `yaml:"persona,omitempty" json:"persona,omitempty"`           // Empty = account persona
+	Tone         string `yaml:"tone,omitempty" json:"tone,omitempty"`                 // Empty = account tone
+	Instructions string `yaml:"instructions,omitempty" json:"instructions,omitempty"` // Added to the reply prompt
+}
+
+// PostingConfig controls when the posting queue posts an account's replies
+type PostingConfig struct {
+	JitterSecs  int    `yaml:"jitter_secs" json:"jitterSecs"`                // Random delay of up to this long added to each slot
+	Timezone    string `yaml:"timezone,omitempty" json:"timezone,omitempty"` // IANA name for active hours (empty = local time)
+	ActiveStart int    `yaml:"active_start" json:"activeStart"`              // Hour replies may start posting (0-23)
+	ActiveEnd   int    `yaml:"active_end" json:"activeEnd"`                  // Hour posting stops (0-23); equal to start = all day
+	SpreadDaily bool   `yaml:"spread_daily" json:"spreadDaily"`              // Space replies so the daily limit spans the active hours
+}
+
+// Location returns the timezone active hours are in
+func (p PostingConfig) Location() (*time.Location, error) {
+	if p.Timezone == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(p.Timezone)
+}
+
+// ActiveWindow returns how long posting is allowed each day
+func (p PostingConfig) ActiveWindow() time.Duration {
+	hours := (p.ActiveEnd - p.ActiveStart + 24) % 24
+	if hours == 0 {
+		hours = 24
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// NextActive returns t if it falls within active hours, otherwise the start of
+// the next active window
+func (p PostingConfig) NextActive(t time.Time) time.Time {
+	if p.ActiveStart == p.ActiveEnd {
+		return t
+	}
+	loc, err := p.Location()
+	if err != nil {
+		loc = time.Local
+	}
+
+	local := t.In(loc)
+	hour := local.Hour()
+	active := hour >= p.ActiveStart && hour < p.ActiveEnd
+	if p.ActiveStart > p.ActiveEnd { // Window crosses midnight
+		active = hour >= p.ActiveStart || hour < p.ActiveEnd
+	}
+	if active {
+		return t
+	}
+
+	start := time.Date(local.Year(), local.Month(), local.Day(), p.ActiveStart, 0, 0, 0, loc)
+	if !start.After(local) {
+		start = start.AddDate(0, 0, 1)
+	}
+	return start
 }
 
 // RateLimits holds rate limiting configuration
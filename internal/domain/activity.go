@@ -13,6 +13,7 @@ const (
 	ActivityTypeRateLimit  ActivityType = "rate_limit"
 	ActivityTypeWorker     ActivityType = "worker"
 	ActivityTypeConfig     ActivityType = "config"
+	ActivityTypePost       ActivityType = "post"
 )
 
 // ActivityLevel represents the severity level
@@ -34,4 +35,6 @@ type ActivityLog struct {
 	Message   string        `json:"message"`
 	Details   string        `json:"details,omitempty"`
 	Timestamp time.Time     `json:"timestamp"`
+	ReplyID   string        `json:"replyId,omitempty"`   // Reply the entry is about
+	UndoUntil *time.Time    `json:"undoUntil,omitempty"` // The reply can be undone from the feed until then
 }
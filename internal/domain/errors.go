@@ -23,6 +23,13 @@ var (
 	ErrReplyFailed         = errors.New("failed to post reply")
 	ErrSearchFailed        = errors.New("search failed")
 	ErrAlreadyReplied      = errors.New("already replied to this tweet")
+	ErrReplyExpired        = errors.New("reply expired before it was approved")
+	ErrDuplicatePost       = errors.New("X rejected the post as a duplicate")
+	ErrReplyRestricted     = errors.New("the author restricted who can reply")
+	ErrAccountLocked       = errors.New("account is locked or suspended")
+	ErrPostRejected        = errors.New("X rejected the post as automated or spam")
+	ErrPostUnconfirmed     = errors.New("could not confirm the post was sent")
+	ErrDeleteUnconfirmed   = errors.New("could not confirm the post was deleted")
 
 	// LLM errors
 	ErrLLMFailed           = errors.New("LLM request failed")
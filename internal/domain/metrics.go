@@ -35,6 +35,10 @@ type ReplyMetrics struct {
 	LikeCount       int       `json:"likeCount"`
 	RetweetCount    int       `json:"retweetCount"`
 	Impressions     int       `json:"impressions"`
+
+	// Reply details, filled in for reports
+	Text          string `json:"text,omitempty"`
+	PostedReplyID string `json:"postedReplyId,omitempty"`
 }
 
 // ProfileGrowthMetrics represents follower growth over a period
@@ -77,3 +81,68 @@ type DailyStats struct {
 	RepliesFailed   int       `json:"repliesFailed"`
 	TokensUsed      int       `json:"tokensUsed"`
 }
+
+// AccountReplyStats summarizes reply activity for an account over a period
+type AccountReplyStats struct {
+	AccountID string `json:"accountId"`
+	Username  string `json:"username"`
+	Generated int    `json:"generated"`
+	Posted    int    `json:"posted"`
+	Failed    int    `json:"failed"`
+	Rejected  int    `json:"rejected"`
+	Pending   int    `json:"pending"`
+}
+
+// KeywordStats summarizes how a keyword (or keyword group) converts into replies
+type KeywordStats struct {
+	Keyword          string  `json:"keyword"`
+	TweetsFound      int     `json:"tweetsFound"`
+	RepliesGenerated int     `json:"repliesGenerated"`
+	RepliesPosted    int     `json:"repliesPosted"`
+	Likes            int     `json:"likes"`          // On our posted replies, latest snapshot
+	Retweets         int     `json:"retweets"`       // On our posted replies, latest snapshot
+	Impressions      int     `json:"impressions"`    // On our posted replies, latest snapshot
+	ConversionRate   float64 `json:"conversionRate"` // Posted replies per tweet found
+}
+
+// ReplyOutcome is a reply's status and engagement at a fixed age, used to
+// compare experiment variants
+type ReplyOutcome struct {
+	ReplyID     string      `json:"replyId"`
+	Variant     string      `json:"variant"`
+	Status      ReplyStatus `json:"status"`
+	Measured    bool        `json:"measured"` // Has a metrics snapshot at the compared age
+	LikeCount   int         `json:"likeCount"`
+	Impressions int         `json:"impressions"`
+}
+
+// VariantResult summarizes the engagement of one experiment variant
+type VariantResult struct {
+	Name           string  `json:"name"`
+	Weight         int     `json:"weight"` // 0 if the variant was removed from the experiment
+	Generated      int     `json:"generated"`
+	Posted         int     `json:"posted"`
+	Measured       int     `json:"measured"` // Posted replies with engagement metrics at the checkpoint
+	AvgLikes       float64 `json:"avgLikes"`
+	AvgImpressions float64 `json:"avgImpressions"`
+	LikeLift       float64 `json:"likeLift"` // Avg likes relative to the baseline, e.g. 0.25 = 25% more
+	PValue         float64 `json:"pValue"`   // Two-sided, avg likes against the baseline; 1 if not computed
+	Significance   string  `json:"significance"`
+}
+
+// Experiment significance labels
+const (
+	SignificanceBaseline     = "baseline"
+	SignificanceSignificant  = "significant"
+	SignificanceInconclusive = "not significant"
+	SignificanceTooFew       = "too few replies"
+)
+
+// ExperimentResults compares an account's experiment variants; the first
+// variant is the baseline
+type ExperimentResults struct {
+	AccountID       string          `json:"accountId"`
+	Enabled         bool            `json:"enabled"`
+	CheckpointHours int             `json:"checkpointHours"` // Reply age engagement is compared at
+	Variants        []VariantResult `json:"variants"`
+}
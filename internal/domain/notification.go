@@ -7,9 +7,40 @@ type NotificationChannel string
 
 const (
 	NotificationChannelTelegram NotificationChannel = "telegram"
-	// Future channels: discord, slack, webhook, etc.
+	NotificationChannelDiscord  NotificationChannel = "discord"
+	NotificationChannelSlack    NotificationChannel = "slack"
+	NotificationChannelWebhook  NotificationChannel = "webhook"
+	NotificationChannelDesktop  NotificationChannel = "desktop"
+	NotificationChannelEmail    NotificationChannel = "email"
 )
 
+// NotificationFormat represents the markup a channel expects messages in
+type NotificationFormat string
+
+const (
+	NotificationFormatHTML     NotificationFormat = "html"
+	NotificationFormatMarkdown NotificationFormat = "markdown"
+	NotificationFormatJSON     NotificationFormat = "json"
+	NotificationFormatText     NotificationFormat = "text"
+)
+
+// Format returns the message format used by the channel
+func (c NotificationChannel) Format() NotificationFormat {
+	switch c {
+	case NotificationChannelDiscord, NotificationChannelSlack:
+		return NotificationFormatMarkdown
+	case NotificationChannelWebhook:
+		return NotificationFormatJSON
+	case NotificationChannelDesktop:
+		return NotificationFormatText
+	default:
+		return NotificationFormatHTML
+	}
+}
+
+// polymarketProfileURL is the base URL for wallet profile pages
+const polymarketProfileURL = "https://polymarket.com/profile/"
+
 // NotificationEventType represents the type of notification event
 type NotificationEventType string
 
@@ -17,8 +48,45 @@ const (
 	NotificationEventBigTrade     NotificationEventType = "big_trade"
 	NotificationEventFreshWallet  NotificationEventType = "fresh_wallet"
 	NotificationEventTest         NotificationEventType = "test"
+	NotificationEventDigest       NotificationEventType = "digest"
+
+	// Twitter automation events
+	NotificationEventApprovalRequired NotificationEventType = "approval_required"
+	NotificationEventReplyFailed      NotificationEventType = "reply_failed"
+	NotificationEventAccountError     NotificationEventType = "account_error"
+)
+
+// Account error statuses reported by the account service
+const (
+	AccountErrorAuthFailed     = "auth_failed"
+	AccountErrorCookiesExpired = "cookies_expired"
+)
+
+// SMTPSecurity represents how the SMTP connection is secured
+type SMTPSecurity string
+
+const (
+	SMTPSecurityNone     SMTPSecurity = "none"
+	SMTPSecuritySTARTTLS SMTPSecurity = "starttls"
+	SMTPSecurityTLS      SMTPSecurity = "tls"
 )
 
+// SMTPConfig holds settings for the email notification channel
+type SMTPConfig struct {
+	Host       string       `json:"host"`
+	Port       int          `json:"port"`
+	Security   SMTPSecurity `json:"security"`
+	Username   string       `json:"username"`
+	Password   string       `json:"password"`
+	From       string       `json:"from"`
+	Recipients []string     `json:"recipients"`
+}
+
+// IsConfigured returns true if enough settings are present to send mail
+func (c SMTPConfig) IsConfigured() bool {
+	return c.Host != "" && c.Port > 0 && c.From != "" && len(c.Recipients) > 0
+}
+
 // NotificationConfig holds configuration for notifications
 type NotificationConfig struct {
 	// General settings
@@ -29,9 +97,78 @@ type NotificationConfig struct {
 	TelegramBotToken string   `json:"telegramBotToken"`
 	TelegramChatIDs  []string `json:"telegramChatIDs"`
 
+	// Email settings
+	SMTP SMTPConfig `json:"smtp"`
+
+	// Webhook settings
+	DiscordWebhookURL string `json:"discordWebhookURL"`
+	SlackWebhookURL   string `json:"slackWebhookURL"`
+	WebhookURL        string `json:"webhookURL"` // Receives the rendered JSON template
+
 	// Notification type toggles
 	NotifyBigTrades    bool `json:"notifyBigTrades"`
 	NotifyFreshWallets bool `json:"notifyFreshWallets"`
+
+	// Twitter automation toggles
+	NotifyApprovalQueue   bool `json:"notifyApprovalQueue"`
+	NotifyReplyFailures   bool `json:"notifyReplyFailures"`
+	NotifyAccountErrors   bool `json:"notifyAccountErrors"`
+	ReplyFailureThreshold int  `json:"replyFailureThreshold"` // Consecutive failures per account before alerting
+
+	// Daily digest email
+	DigestEnabled bool `json:"digestEnabled"`
+	DigestHour    int  `json:"digestHour"` // Local hour (0-23) the digest is sent at
+
+	// User-defined message templates (falls back to built-in defaults)
+	Templates []NotificationTemplate `json:"templates,omitempty"`
+
+	// Channels to deliver each event type to (falls back to Channel)
+	Routes map[NotificationEventType][]NotificationChannel `json:"routes,omitempty"`
+}
+
+// ChannelsFor returns the channels an event type should be delivered to
+func (c *NotificationConfig) ChannelsFor(eventType NotificationEventType) []NotificationChannel {
+	if channels, ok := c.Routes[eventType]; ok {
+		return channels
+	}
+	if c.Channel != "" {
+		return []NotificationChannel{c.Channel}
+	}
+	return []NotificationChannel{NotificationChannelTelegram}
+}
+
+// ActiveChannels returns every channel referenced by the routing config
+func (c *NotificationConfig) ActiveChannels() []NotificationChannel {
+	seen := make(map[NotificationChannel]bool)
+	var channels []NotificationChannel
+
+	add := func(list []NotificationChannel) {
+		for _, ch := range list {
+			if !seen[ch] {
+				seen[ch] = true
+				channels = append(channels, ch)
+			}
+		}
+	}
+
+	add(c.ChannelsFor(NotificationEventBigTrade))
+	add(c.ChannelsFor(NotificationEventFreshWallet))
+	add(c.ChannelsFor(NotificationEventApprovalRequired))
+	add(c.ChannelsFor(NotificationEventReplyFailed))
+	add(c.ChannelsFor(NotificationEventAccountError))
+	for _, list := range c.Routes {
+		add(list)
+	}
+
+	return channels
+}
+
+// NotificationTemplate is a Go text/template used to render a message
+// for a given event type on a given channel
+type NotificationTemplate struct {
+	EventType NotificationEventType `json:"eventType"`
+	Channel   NotificationChannel   `json:"channel"`
+	Body      string                `json:"body"`
 }
 
 // DefaultNotificationConfig returns default notification configuration
@@ -43,18 +180,48 @@ func DefaultNotificationConfig() NotificationConfig {
 		TelegramChatIDs:    []string{},
 		NotifyBigTrades:    false,
 		NotifyFreshWallets: false,
+		NotifyApprovalQueue:   false,
+		NotifyReplyFailures:   false,
+		NotifyAccountErrors:   false,
+		ReplyFailureThreshold: 3,
+		SMTP: SMTPConfig{
+			Port:       587,
+			Security:   SMTPSecuritySTARTTLS,
+			Recipients: []string{},
+		},
+		DigestHour: 8,
 	}
 }
 
-// IsConfigured returns true if the notification channel is properly configured
+// IsConfigured returns true if at least one routed channel is properly configured
 func (c *NotificationConfig) IsConfigured() bool {
 	if !c.Enabled {
 		return false
 	}
 
-	switch c.Channel {
+	for _, channel := range c.ActiveChannels() {
+		if c.IsChannelConfigured(channel) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsChannelConfigured returns true if the given channel has the settings it needs
+func (c *NotificationConfig) IsChannelConfigured(channel NotificationChannel) bool {
+	switch channel {
 	case NotificationChannelTelegram:
 		return c.TelegramBotToken != "" && len(c.TelegramChatIDs) > 0
+	case NotificationChannelDesktop:
+		return true // Delivered through the running app, nothing to configure
+	case NotificationChannelEmail:
+		return c.SMTP.IsConfigured()
+	case NotificationChannelDiscord:
+		return c.DiscordWebhookURL != ""
+	case NotificationChannelSlack:
+		return c.SlackWebhookURL != ""
+	case NotificationChannelWebhook:
+		return c.WebhookURL != ""
 	default:
 		return false
 	}
@@ -67,6 +234,7 @@ type NotificationContent struct {
 	Message     string                 `json:"message"`
 	Timestamp   time.Time              `json:"timestamp"`
 	Priority    string                 `json:"priority"` // "high", "medium", "low"
+	Link        string                 `json:"link,omitempty"` // Click-through URL (market or wallet page)
 	Metadata    map[string]string      `json:"metadata"`
 }
 
@@ -77,20 +245,6 @@ func NewBigTradeNotification(event PolymarketEvent) NotificationContent {
 		side = "SELL"
 	}
 
-	sideEmoji := "🟢"
-	if side == "SELL" {
-		sideEmoji = "🔴"
-	}
-
-	// Calculate notional value
-	var notional float64
-	if event.Price != "" && event.Size != "" {
-		var p, s float64
-		parseFloatSimple(event.Price, &p)
-		parseFloatSimple(event.Size, &s)
-		notional = p * s
-	}
-
 	metadata := map[string]string{
 		"market":        event.EventTitle,
 		"outcome":       event.Outcome,
@@ -100,39 +254,30 @@ func NewBigTradeNotification(event PolymarketEvent) NotificationContent {
 	}
 
 	// Include wallet info if available
-	betCount := ""
-	joinDate := ""
 	if event.WalletProfile != nil {
-		betCount = formatInt(event.WalletProfile.BetCount)
-		joinDate = event.WalletProfile.JoinDate
-		metadata["betCount"] = betCount
-		metadata["joinDate"] = joinDate
+		metadata["betCount"] = formatInt(event.WalletProfile.BetCount)
+		metadata["joinDate"] = event.WalletProfile.JoinDate
 	}
 
-	message := formatBigTradeMessage(event.EventTitle, event.Outcome, notional, side, sideEmoji, event.WalletAddress, betCount, joinDate)
+	// Prefer the market page, fall back to the trader's profile
+	link := event.MarketLink
+	if link == "" && event.WalletAddress != "" {
+		link = polymarketProfileURL + event.WalletAddress
+	}
 
+	// Message is rendered per channel from the configured template
 	return NotificationContent{
 		EventType: NotificationEventBigTrade,
 		Title:     "Big Trade Alert",
-		Message:   message,
 		Timestamp: event.Timestamp,
 		Priority:  "high",
+		Link:      link,
 		Metadata:  metadata,
 	}
 }
 
 // NewFreshWalletNotification creates a notification for a fresh wallet detection
 func NewFreshWalletNotification(profile WalletProfile) NotificationContent {
-	freshnessEmoji := "🚨"
-	switch profile.FreshnessLevel {
-	case FreshnessInsider:
-		freshnessEmoji = "🚨"
-	case FreshnessWallet:
-		freshnessEmoji = "🔥"
-	case FreshnessNewbie:
-		freshnessEmoji = "⚡"
-	}
-
 	metadata := map[string]string{
 		"walletAddress":  profile.Address,
 		"betCount":       formatInt(profile.BetCount),
@@ -140,130 +285,88 @@ func NewFreshWalletNotification(profile WalletProfile) NotificationContent {
 		"freshnessLevel": string(profile.FreshnessLevel),
 	}
 
-	message := formatFreshWalletMessage(freshnessEmoji, profile.Address, profile.BetCount, profile.JoinDate, string(profile.FreshnessLevel))
-
+	// Message is rendered per channel from the configured template
 	return NotificationContent{
 		EventType: NotificationEventFreshWallet,
 		Title:     "Fresh Wallet Detected",
-		Message:   message,
 		Timestamp: profile.AnalyzedAt,
 		Priority:  "high",
+		Link:      polymarketProfileURL + profile.Address,
 		Metadata:  metadata,
 	}
 }
 
-// NewTestNotification creates a test notification
-func NewTestNotification() NotificationContent {
+// NewApprovalRequiredNotification creates a notification for replies awaiting approval
+func NewApprovalRequiredNotification(totalPending int) NotificationContent {
 	return NotificationContent{
-		EventType: NotificationEventTest,
-		Title:     "Test Notification",
-		Message:   "This is a test notification from XTools Polymarket Watcher. If you received this, your Telegram notifications are working correctly!",
+		EventType: NotificationEventApprovalRequired,
+		Title:     "Replies Awaiting Approval",
 		Timestamp: time.Now(),
-		Priority:  "low",
-		Metadata:  make(map[string]string),
+		Priority:  "medium",
+		Metadata: map[string]string{
+			"totalPending": formatInt(totalPending),
+		},
 	}
 }
 
-// Helper functions for formatting
-
-func formatBigTradeMessage(market, outcome string, value float64, side, sideEmoji, wallet, betCount, joinDate string) string {
-	msg := "<b>" + sideEmoji + " Big Trade Alert</b>\n\n"
-
-	if market != "" {
-		msg += "<b>Market:</b> " + escapeHTML(market) + "\n"
-	}
-	if outcome != "" {
-		msg += "<b>Outcome:</b> " + escapeHTML(outcome) + "\n"
-	}
-	msg += "<b>Value:</b> $" + formatFloat(value, 2) + "\n"
-	msg += "<b>Side:</b> " + side + "\n"
-	if wallet != "" {
-		msg += "<b>Wallet:</b> <code>" + escapeHTML(shortenAddr(wallet)) + "</code>\n"
-	}
-	if betCount != "" {
-		msg += "<b>Trades:</b> " + betCount + "\n"
-	}
-	if joinDate != "" {
-		msg += "<b>Join Date:</b> " + escapeHTML(joinDate) + "\n"
-	}
-
-	if wallet != "" {
-		msg += "\n<a href=\"https://polymarket.com/profile/" + wallet + "\">View Profile</a>"
+// NewReplyFailedNotification creates a notification for repeated reply failures
+func NewReplyFailedNotification(accountID string, failures int, lastError string) NotificationContent {
+	return NotificationContent{
+		EventType: NotificationEventReplyFailed,
+		Title:     "Replies Failing",
+		Timestamp: time.Now(),
+		Priority:  "high",
+		Metadata: map[string]string{
+			"accountId": accountID,
+			"failures":  formatInt(failures),
+			"error":     lastError,
+		},
 	}
-
-	return msg
 }
 
-func formatFreshWalletMessage(emoji, wallet string, betCount int, joinDate, level string) string {
-	msg := "<b>" + emoji + " Fresh Wallet Detected</b>\n\n"
-
-	if wallet != "" {
-		msg += "<b>Wallet:</b> <code>" + escapeHTML(shortenAddr(wallet)) + "</code>\n"
-	}
-	msg += "<b>Total Trades:</b> " + formatInt(betCount) + "\n"
-	if joinDate != "" {
-		msg += "<b>Join Date:</b> " + escapeHTML(joinDate) + "\n"
+// NewAccountErrorNotification creates a notification for an account authentication problem
+func NewAccountErrorNotification(accountID, status, errMsg string) NotificationContent {
+	title := "Account Authentication Failed"
+	if status == AccountErrorCookiesExpired {
+		title = "Account Cookies Expired"
 	}
-	msg += "<b>Freshness:</b> " + escapeHTML(level) + "\n"
 
-	if wallet != "" {
-		msg += "\n<a href=\"https://polymarket.com/profile/" + wallet + "\">View Profile</a>"
-	}
-
-	return msg
-}
-
-func escapeHTML(s string) string {
-	result := ""
-	for _, c := range s {
-		switch c {
-		case '<':
-			result += "&lt;"
-		case '>':
-			result += "&gt;"
-		case '&':
-			result += "&amp;"
-		case '"':
-			result += "&quot;"
-		default:
-			result += string(c)
-		}
+	return NotificationContent{
+		EventType: NotificationEventAccountError,
+		Title:     title,
+		Timestamp: time.Now(),
+		Priority:  "high",
+		Metadata: map[string]string{
+			"accountId": accountID,
+			"status":    status,
+			"error":     errMsg,
+		},
 	}
-	return result
 }
 
-func shortenAddr(addr string) string {
-	if len(addr) <= 10 {
-		return addr
+// NewTestNotification creates a test notification
+func NewTestNotification() NotificationContent {
+	return NotificationContent{
+		EventType: NotificationEventTest,
+		Title:     "Test Notification",
+		Message:   "This is a test notification from XTools Polymarket Watcher. If you received this, your notifications are working correctly!",
+		Timestamp: time.Now(),
+		Priority:  "low",
+		Metadata:  make(map[string]string),
 	}
-	return addr[:6] + "..." + addr[len(addr)-4:]
 }
 
-func formatFloat(f float64, decimals int) string {
-	if decimals == 2 {
-		return formatFloatStr(f, 100)
-	}
-	return formatFloatStr(f, 1)
+// NotificationDigest summarizes a period of activity for the daily digest email
+type NotificationDigest struct {
+	Since        time.Time           `json:"since"`
+	Until        time.Time           `json:"until"`
+	TopTrades    []PolymarketEvent   `json:"topTrades"`
+	FreshWallets []WalletProfile     `json:"freshWallets"`
+	ReplyStats   []AccountReplyStats `json:"replyStats"`
+	TopReplies   []ReplyMetrics      `json:"topReplies"`
 }
 
-func formatFloatStr(f float64, mult float64) string {
-	// Simple float formatting without fmt package
-	rounded := int64(f*mult + 0.5)
-	intPart := rounded / int64(mult)
-	fracPart := rounded % int64(mult)
-
-	intStr := formatInt64(intPart)
-	if mult == 1 {
-		return intStr
-	}
-
-	fracStr := formatInt64(fracPart)
-	if mult == 100 && fracPart < 10 {
-		fracStr = "0" + fracStr
-	}
-
-	return intStr + "." + fracStr
-}
+// Helper functions for formatting
 
 func formatInt(i int) string {
 	return formatInt64(int64(i))
@@ -284,33 +387,3 @@ func formatInt64(i int64) string {
 	}
 	return result
 }
-
-func parseFloatSimple(str string, v *float64) {
-	if str == "" {
-		*v = 0
-		return
-	}
-
-	val := 0.0
-	multiplier := 1.0
-	decimal := false
-	decimalPlace := 0.1
-
-	for _, c := range str {
-		if c == '-' {
-			multiplier = -1
-		} else if c == '.' {
-			decimal = true
-		} else if c >= '0' && c <= '9' {
-			digit := float64(c - '0')
-			if decimal {
-				val += digit * decimalPlace
-				decimalPlace /= 10
-			} else {
-				val = val*10 + digit
-			}
-		}
-	}
-
-	*v = val * multiplier
-}
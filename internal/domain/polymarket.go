@@ -135,6 +135,8 @@ type PolymarketEventFilter struct {
 	FreshWalletsOnly bool                  `json:"freshWalletsOnly,omitempty"`
 	MinRiskScore     float64               `json:"minRiskScore,omitempty"`
 	MaxWalletNonce   int                   `json:"maxWalletNonce,omitempty"`
+	Since            time.Time             `json:"since,omitempty"`
+	SortByValue      bool                  `json:"sortByValue,omitempty"` // Order by notional value instead of time
 }
 
 // PolymarketWatcherStatus represents the current status of the watcher
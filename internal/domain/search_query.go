@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// SearchDateLayout is the date format of SearchQuery.Since and Until
+const SearchDateLayout = "2006-01-02"
+
+// SearchQuery is a structured search on top of the account keywords.
+// Each client renders it in its own syntax.
+type SearchQuery struct {
+	AnyWords     []string `yaml:"any_words,omitempty" json:"anyWords,omitempty"`         // At least one of these
+	AllWords     []string `yaml:"all_words,omitempty" json:"allWords,omitempty"`         // Every one of these
+	ExactPhrases []string `yaml:"exact_phrases,omitempty" json:"exactPhrases,omitempty"` // Each phrase as written
+	NoneWords    []string `yaml:"none_words,omitempty" json:"noneWords,omitempty"`       // None of these
+	FromUsers    []string `yaml:"from_users,omitempty" json:"fromUsers,omitempty"`       // Posted by any of these
+	ToUsers      []string `yaml:"to_users,omitempty" json:"toUsers,omitempty"`           // Replying to any of these
+	Mentions     []string `yaml:"mentions,omitempty" json:"mentions,omitempty"`          // Mentioning any of these
+	Hashtags     []string `yaml:"hashtags,omitempty" json:"hashtags,omitempty"`          // Containing any of these
+	Lang         string   `yaml:"lang,omitempty" json:"lang,omitempty"`
+	HasMedia     bool     `yaml:"has_media,omitempty" json:"hasMedia,omitempty"`
+	HasLinks     bool     `yaml:"has_links,omitempty" json:"hasLinks,omitempty"`
+	VerifiedOnly bool     `yaml:"verified_only,omitempty" json:"verifiedOnly,omitempty"`
+	Since        string   `yaml:"since,omitempty" json:"since,omitempty"` // YYYY-MM-DD, inclusive
+	Until        string   `yaml:"until,omitempty" json:"until,omitempty"` // YYYY-MM-DD, exclusive
+	MinViews     int      `yaml:"min_views,omitempty" json:"minViews,omitempty"`
+}
+
+var (
+	searchUsernameRe = regexp.MustCompile(`^@?[A-Za-z0-9_]{1,15}$`)
+	searchHashtagRe  = regexp.MustCompile(`^#?[\p{L}\p{N}_]+$`)
+	searchLangRe     = regexp.MustCompile(`^[a-z]{2,3}$`)
+)
+
+// HasTerms returns true if the query matches on words, users or hashtags
+func (q *SearchQuery) HasTerms() bool {
+	return q != nil && len(q.AnyWords)+len(q.AllWords)+len(q.ExactPhrases)+
+		len(q.FromUsers)+len(q.ToUsers)+len(q.Mentions)+len(q.Hashtags) > 0
+}
+
+// Validate checks the query for values neither search syntax accepts
+func (q *SearchQuery) Validate() error {
+	if q == nil {
+		return nil
+	}
+
+	for _, w := range concat(q.AnyWords, q.AllWords, q.NoneWords) {
+		if strings.TrimSpace(w) == "" || strings.ContainsAny(w, " \t\"") {
+			return fmt.Errorf("invalid search word %q: use single words, or exact phrases for several", w)
+		}
+	}
+	for _, p := range q.ExactPhrases {
+		if strings.TrimSpace(p) == "" || strings.Contains(p, `"`) {
+			return fmt.Errorf("invalid exact phrase %q", p)
+		}
+	}
+
+	for _, u := range concat(q.FromUsers, q.ToUsers, q.Mentions) {
+		if !searchUsernameRe.MatchString(strings.TrimSpace(u)) {
+			return fmt.Errorf("invalid username %q: usernames are 1-15 letters, digits or underscores", u)
+		}
+	}
+	for _, h := range q.Hashtags {
+		if !searchHashtagRe.MatchString(h) {
+			return fmt.Errorf("invalid hashtag %q", h)
+		}
+	}
+
+	if q.Lang != "" && !searchLangRe.MatchString(q.Lang) {
+		return fmt.Errorf("invalid language %q: use a language code such as en", q.Lang)
+	}
+	if q.MinViews < 0 {
+		return fmt.Errorf("min views must not be negative")
+	}
+
+	since, until, err := q.TimeRange()
+	if err != nil {
+		return err
+	}
+	if !since.IsZero() && !until.IsZero() && !since.Before(until) {
+		return fmt.Errorf("search since date must be before until date")
+	}
+
+	return nil
+}
+
+func concat(lists ...[]string) []string {
+	var out []string
+	for _, l := range lists {
+		out = append(out, l...)
+	}
+	return out
+}
+
+// TimeRange parses the since and until dates; unset dates are zero
+func (q *SearchQuery) TimeRange() (since, until time.Time, err error) {
+	if q == nil {
+		return
+	}
+	if q.Since != "" {
+		if since, err = time.ParseInLocation(SearchDateLayout, q.Since, time.UTC); err != nil {
+			return since, until, fmt.Errorf("invalid since date %q: use YYYY-MM-DD", q.Since)
+		}
+	}
+	if q.Until != "" {
+		if until, err = time.ParseInLocation(SearchDateLayout, q.Until, time.UTC); err != nil {
+			return since, until, fmt.Errorf("invalid until date %q: use YYYY-MM-DD", q.Until)
+		}
+	}
+	return since, until, nil
+}
+
+// QueryFor returns the account query with the keywords added as any-of terms
+func (c SearchConfig) QueryFor(keywords []string) SearchQuery {
+	var q SearchQuery
+	if c.Query != nil {
+		q = *c.Query
+	}
+	q.AnyWords = append(append([]string{}, keywords...), q.AnyWords...)
+	return q
+}
@@ -13,6 +13,14 @@ type Tweet struct {
 	CreatedAt      time.Time `json:"createdAt"`
 	Language       string    `json:"language"`
 
+	// Author profile at discovery time (zero when the client could not read it)
+	AuthorFollowers    int        `json:"authorFollowers,omitempty"`
+	AuthorFollowing    int        `json:"authorFollowing,omitempty"`
+	AuthorTweetCount   int        `json:"authorTweetCount,omitempty"`
+	AuthorVerified     bool       `json:"authorVerified,omitempty"`
+	AuthorDefaultImage bool       `json:"authorDefaultImage,omitempty"`
+	AuthorCreatedAt    *time.Time `json:"authorCreatedAt,omitempty"`
+
 	// Engagement metrics at discovery time
 	LikeCount    int `json:"likeCount"`
 	RetweetCount int `json:"retweetCount"`
@@ -28,6 +36,13 @@ type Tweet struct {
 	MatchedKeywords []string  `json:"matchedKeywords"`
 	DiscoveredAt    time.Time `json:"discoveredAt"`
 	AccountID       string    `json:"accountId"`
+	AuthorScore     int       `json:"authorScore,omitempty"` // 0-100, see SearchConfig.MinAuthorScore
+	Allowlisted     bool      `json:"allowlisted,omitempty"` // Author is on the account's allowlist
+
+	// LLM relevance screening (set when the account's relevance filter is enabled)
+	Relevance       *float64 `json:"relevance,omitempty"` // 0-1
+	Sentiment       string   `json:"sentiment,omitempty"` // "positive", "neutral" or "negative"
+	RelevanceReason string   `json:"relevanceReason,omitempty"`
 }
 
 // User represents a Twitter user profile
@@ -42,29 +57,67 @@ type User struct {
 	Verified       bool   `json:"verified"`
 }
 
+// ReplyType distinguishes replies from standalone tweets drafted by the app
+type ReplyType string
+
+const (
+	ReplyTypeReply ReplyType = ""      // Reply to TweetID
+	ReplyTypeTweet ReplyType = "tweet" // Standalone tweet; TweetID identifies the source item
+)
+
 // ReplyStatus defines the status of a generated reply
 type ReplyStatus string
 
 const (
-	ReplyStatusPending  ReplyStatus = "pending"
-	ReplyStatusApproved ReplyStatus = "approved"
-	ReplyStatusPosted   ReplyStatus = "posted"
-	ReplyStatusRejected ReplyStatus = "rejected"
-	ReplyStatusFailed   ReplyStatus = "failed"
+	ReplyStatusPending   ReplyStatus = "pending"
+	ReplyStatusApproved  ReplyStatus = "approved"
+	ReplyStatusScheduled ReplyStatus = "scheduled" // Approved and waiting in the posting queue
+	ReplyStatusPosted    ReplyStatus = "posted"
+	ReplyStatusRejected  ReplyStatus = "rejected"
+	ReplyStatusFailed    ReplyStatus = "failed"
+	ReplyStatusExpired   ReplyStatus = "expired" // Left in the approval queue past its expiry
+	ReplyStatusDeleted   ReplyStatus = "deleted" // Posted, then deleted from X
 )
 
 // Reply represents a reply to a tweet
 type Reply struct {
-	ID            string      `json:"id"`
-	TweetID       string      `json:"tweetId"`
-	AccountID     string      `json:"accountId"`
-	Text          string      `json:"text"`
-	GeneratedAt   time.Time   `json:"generatedAt"`
-	PostedAt      *time.Time  `json:"postedAt,omitempty"`
-	Status        ReplyStatus `json:"status"`
-	LLMTokensUsed int         `json:"llmTokensUsed"`
-	ErrorMessage  string      `json:"errorMessage,omitempty"`
-	PostedReplyID string      `json:"postedReplyId,omitempty"`
+	ID            string            `json:"id"`
+	TweetID       string            `json:"tweetId"`
+	AccountID     string            `json:"accountId"`
+	Text          string            `json:"text"`
+	GeneratedAt   time.Time         `json:"generatedAt"`
+	PostedAt      *time.Time        `json:"postedAt,omitempty"`
+	Status        ReplyStatus       `json:"status"`
+	LLMTokensUsed int               `json:"llmTokensUsed"`
+	ErrorMessage  string            `json:"errorMessage,omitempty"`
+	PostedReplyID string            `json:"postedReplyId,omitempty"`
+	Type          ReplyType         `json:"type,omitempty"`
+	Media         []MediaAttachment `json:"media,omitempty"`
+	TweetAuthor   string            `json:"tweetAuthor,omitempty"`   // Username of the author replied to
+	ScheduledFor  *time.Time        `json:"scheduledFor,omitempty"`  // When the posting queue will post it
+	Violations    []string          `json:"violations,omitempty"`    // Guardrail checks the reply failed
+	Variant       string            `json:"variant,omitempty"`       // Experiment variant that wrote it
+	OriginalTweet *Tweet            `json:"originalTweet,omitempty"` // Tweet replied to, filled in reply history
+	FollowUpDepth int               `json:"followUpDepth,omitempty"` // Follow-ups to a reply to ours: 1 for the first (0 = not a follow-up)
+
+	// Variants offered for review; Text starts as the chosen one
+	Candidates      []ReplyCandidate `json:"candidates,omitempty"`
+	ChosenCandidate int              `json:"chosenCandidate"`
+}
+
+// ReplyCandidate is one generated variant of a reply
+type ReplyCandidate struct {
+	Text        string  `json:"text"`
+	Style       string  `json:"style,omitempty"` // Direction given to the LLM for this variant
+	Temperature float64 `json:"temperature"`
+	TokensUsed  int     `json:"tokensUsed"`
+}
+
+// MediaAttachment is an image or GIF attached to a reply or tweet
+type MediaAttachment struct {
+	Path     string `json:"path"`               // Local file path
+	MimeType string `json:"mimeType,omitempty"` // Detected from the file when empty
+	AltText  string `json:"altText,omitempty"`
 }
 
 // ApprovalQueueItem represents an item in the approval queue
@@ -73,6 +126,32 @@ type ApprovalQueueItem struct {
 	OriginalTweet Tweet     `json:"originalTweet"`
 	QueuedAt      time.Time `json:"queuedAt"`
 	ExpiresAt     time.Time `json:"expiresAt"`
+	Priority      float64   `json:"priority"` // 0-100 from views, author reach and tweet age; computed on read
+}
+
+// PendingSort orders the approval queue
+type PendingSort string
+
+const (
+	PendingSortPriority  PendingSort = "priority"
+	PendingSortQueued    PendingSort = "queued"
+	PendingSortExpires   PendingSort = "expires"
+	PendingSortViews     PendingSort = "views"
+	PendingSortRelevance PendingSort = "relevance" // Unscored tweets go last
+)
+
+// PendingFilter selects approval queue items across accounts
+type PendingFilter struct {
+	AccountIDs  []string    `json:"accountIds,omitempty"` // Empty = all accounts
+	Search      string      `json:"search,omitempty"`     // Matches reply text, tweet text or author
+	MinPriority float64     `json:"minPriority,omitempty"`
+	SortBy      PendingSort `json:"sortBy,omitempty"` // Empty = priority
+}
+
+// BulkResult reports a bulk approval queue action
+type BulkResult struct {
+	Succeeded []string          `json:"succeeded"`
+	Failed    map[string]string `json:"failed"` // Reply ID to error message
 }
 
 // SearchResult represents search results with metadata
@@ -86,8 +165,43 @@ type SearchResult struct {
 
 // RateLimitStatus represents the current rate limit state
 type RateLimitStatus struct {
-	Remaining  int       `json:"remaining"`
-	Limit      int       `json:"limit"`
-	ResetAt    time.Time `json:"resetAt"`
-	IsLimited  bool      `json:"isLimited"`
+	Remaining int       `json:"remaining"`
+	Limit     int       `json:"limit"`
+	ResetAt   time.Time `json:"resetAt"`
+	IsLimited bool      `json:"isLimited"`
+}
+
+// ScheduledPostType defines what kind of original content a scheduled post publishes
+type ScheduledPostType string
+
+const (
+	ScheduledPostTweet  ScheduledPostType = "tweet"
+	ScheduledPostQuote  ScheduledPostType = "quote"
+	ScheduledPostThread ScheduledPostType = "thread"
+)
+
+// ScheduledPostStatus defines the status of a scheduled post
+type ScheduledPostStatus string
+
+const (
+	ScheduledPostStatusScheduled ScheduledPostStatus = "scheduled"
+	ScheduledPostStatusPosting   ScheduledPostStatus = "posting"
+	ScheduledPostStatusPosted    ScheduledPostStatus = "posted"
+	ScheduledPostStatusFailed    ScheduledPostStatus = "failed"
+	ScheduledPostStatusCancelled ScheduledPostStatus = "cancelled"
+)
+
+// ScheduledPost is original content queued for publishing at a given time
+type ScheduledPost struct {
+	ID           string              `json:"id"`
+	AccountID    string              `json:"accountId"`
+	Type         ScheduledPostType   `json:"type"`
+	Texts        []string            `json:"texts"`                  // One text, or one per thread entry
+	QuoteTweetID string              `json:"quoteTweetId,omitempty"` // Tweet being quoted
+	ScheduledAt  time.Time           `json:"scheduledAt"`
+	Status       ScheduledPostStatus `json:"status"`
+	CreatedAt    time.Time           `json:"createdAt"`
+	PostedAt     *time.Time          `json:"postedAt,omitempty"`
+	PostedIDs    []string            `json:"postedIds,omitempty"`
+	ErrorMessage string              `json:"errorMessage,omitempty"`
 }
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	"xtools/internal/adapters/twitter"
@@ -18,6 +19,9 @@ type NotificationServiceInterface interface {
 	GetConfig() domain.NotificationConfig
 	UpdateConfig(config domain.NotificationConfig) error
 	SendTestNotification(ctx context.Context) error
+	SendDigest(ctx context.Context) error
+	PreviewTemplate(tmpl domain.NotificationTemplate) (string, error)
+	GetDefaultTemplates() []domain.NotificationTemplate
 }
 
 // Handlers provides all Wails-bound handler methods
@@ -27,6 +31,9 @@ type Handlers struct {
 	replySvc        *services.ReplyService
 	polymarketSvc   *services.PolymarketService
 	notificationSvc NotificationServiceInterface
+	scheduledSvc    *services.ScheduledPostService
+	queueSvc        *services.ApprovalQueueService
+	replyMetricsSvc *services.ReplyMetricsService
 	workerPool      *workers.WorkerPool
 	configStore     ports.ConfigStore
 	metricsStore    ports.MetricsStore
@@ -42,6 +49,9 @@ func NewHandlers(
 	replySvc *services.ReplyService,
 	polymarketSvc *services.PolymarketService,
 	notificationSvc NotificationServiceInterface,
+	scheduledSvc *services.ScheduledPostService,
+	queueSvc *services.ApprovalQueueService,
+	replyMetricsSvc *services.ReplyMetricsService,
 	workerPool *workers.WorkerPool,
 	configStore ports.ConfigStore,
 	metricsStore ports.MetricsStore,
@@ -54,6 +64,9 @@ func NewHandlers(
 		replySvc:        replySvc,
 		polymarketSvc:   polymarketSvc,
 		notificationSvc: notificationSvc,
+		scheduledSvc:    scheduledSvc,
+		queueSvc:        queueSvc,
+		replyMetricsSvc: replyMetricsSvc,
 		workerPool:      workerPool,
 		configStore:     configStore,
 		metricsStore:    metricsStore,
@@ -179,6 +192,26 @@ func (h *Handlers) SearchTweets(accountID string) ([]domain.Tweet, error) {
 	return tweets, nil
 }
 
+// ImportUsernameList reads usernames from a text or CSV file for a block or allow list
+func (h *Handlers) ImportUsernameList(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	usernames, err := services.ParseUsernameList(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return usernames, nil
+}
+
+// PreviewSearchQuery validates an account's search settings and returns the query they produce
+func (h *Handlers) PreviewSearchQuery(cfg domain.AccountConfig) (string, error) {
+	return h.searchSvc.PreviewQuery(&cfg)
+}
+
 // ManualSearch performs a custom search
 func (h *Handlers) ManualSearch(accountID, query string, maxResults int) ([]domain.Tweet, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -200,11 +233,22 @@ func (h *Handlers) GetPendingReplies(accountID string) ([]domain.ApprovalQueueIt
 	return h.replySvc.GetPendingReplies(accountID)
 }
 
-// ApproveReply approves a pending reply
+// ApproveReply approves a pending reply and schedules it for posting
 func (h *Handlers) ApproveReply(replyID string) error {
+	return h.replySvc.ApproveReply(replyID)
+}
+
+// PostReplyNow posts a scheduled reply on the next posting queue check
+func (h *Handlers) PostReplyNow(replyID string) error {
+	return h.replySvc.PostReplyNow(replyID)
+}
+
+// DeleteReply deletes a posted reply from X
+func (h *Handlers) DeleteReply(replyID string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
 	defer cancel()
-	return h.replySvc.ApproveReply(ctx, replyID)
+
+	return h.replySvc.DeleteReply(ctx, replyID)
 }
 
 // RejectReply rejects a pending reply
@@ -212,11 +256,36 @@ func (h *Handlers) RejectReply(replyID string) error {
 	return h.replySvc.RejectReply(replyID)
 }
 
+// GetAllPendingReplies returns the approval queue across accounts
+func (h *Handlers) GetAllPendingReplies(filter domain.PendingFilter) ([]domain.ApprovalQueueItem, error) {
+	return h.queueSvc.GetAllPendingReplies(filter)
+}
+
+// ApproveReplies approves and posts several pending replies
+func (h *Handlers) ApproveReplies(replyIDs []string) domain.BulkResult {
+	return h.queueSvc.ApproveReplies(replyIDs)
+}
+
+// RejectReplies rejects several pending replies
+func (h *Handlers) RejectReplies(replyIDs []string) domain.BulkResult {
+	return h.queueSvc.RejectReplies(replyIDs)
+}
+
+// SelectReplyCandidate picks one of a pending reply's candidates
+func (h *Handlers) SelectReplyCandidate(replyID string, index int) error {
+	return h.replySvc.SelectReplyCandidate(replyID, index)
+}
+
 // EditReply updates a pending reply's text
 func (h *Handlers) EditReply(replyID, newText string) error {
 	return h.replySvc.EditReply(replyID, newText)
 }
 
+// SetReplyMedia replaces the media attached to a pending reply
+func (h *Handlers) SetReplyMedia(replyID string, media []domain.MediaAttachment) error {
+	return h.replySvc.SetReplyMedia(replyID, media)
+}
+
 // GetReplyHistory returns reply history for an account
 func (h *Handlers) GetReplyHistory(accountID string, limit int) ([]domain.Reply, error) {
 	return h.replySvc.GetReplyHistory(accountID, limit)
@@ -229,6 +298,40 @@ func (h *Handlers) GenerateReply(accountID string, tweet domain.Tweet) (*domain.
 	return h.replySvc.GenerateReply(ctx, accountID, tweet)
 }
 
+// === Scheduled Post Handlers ===
+
+// SchedulePost queues a tweet, quote tweet or thread for publishing
+func (h *Handlers) SchedulePost(post domain.ScheduledPost) (*domain.ScheduledPost, error) {
+	return h.scheduledSvc.SchedulePost(post)
+}
+
+// UpdateScheduledPost edits a post that has not been published yet
+func (h *Handlers) UpdateScheduledPost(post domain.ScheduledPost) error {
+	return h.scheduledSvc.UpdatePost(post)
+}
+
+// CancelScheduledPost cancels a post that has not been published yet
+func (h *Handlers) CancelScheduledPost(postID string) error {
+	return h.scheduledSvc.CancelPost(postID)
+}
+
+// DeleteScheduledPost removes a post from the queue
+func (h *Handlers) DeleteScheduledPost(postID string) error {
+	return h.scheduledSvc.DeletePost(postID)
+}
+
+// PublishScheduledPost publishes a scheduled post immediately
+func (h *Handlers) PublishScheduledPost(postID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	return h.scheduledSvc.PublishNow(ctx, postID)
+}
+
+// GetScheduledPosts returns scheduled posts for an account
+func (h *Handlers) GetScheduledPosts(accountID string, limit int) ([]domain.ScheduledPost, error) {
+	return h.scheduledSvc.GetPosts(accountID, limit)
+}
+
 // === Metrics Handlers ===
 
 // GetProfileHistory returns profile metrics history
@@ -238,7 +341,12 @@ func (h *Handlers) GetProfileHistory(accountID string, days int) ([]domain.Profi
 
 // GetReplyPerformance returns reply performance report
 func (h *Handlers) GetReplyPerformance(accountID string, days int) (*domain.ReplyPerformanceReport, error) {
-	return h.metricsStore.GetReplyPerformance(accountID, days)
+	return h.replyMetricsSvc.GetReplyPerformance(accountID, days)
+}
+
+// GetExperimentResults compares engagement across an account's experiment variants
+func (h *Handlers) GetExperimentResults(accountID string) (*domain.ExperimentResults, error) {
+	return h.replySvc.GetExperimentResults(accountID)
 }
 
 // GetDailyStats returns daily statistics
@@ -246,6 +354,11 @@ func (h *Handlers) GetDailyStats(accountID string, days int) ([]domain.DailyStat
 	return h.metricsStore.GetDailyStats(accountID, days)
 }
 
+// GetKeywordStats returns per-keyword search and reply performance
+func (h *Handlers) GetKeywordStats(accountID string, days int) ([]domain.KeywordStats, error) {
+	return h.searchSvc.GetKeywordStats(accountID, days)
+}
+
 // === Export Handlers ===
 
 // ExportTweets exports tweets to Excel
@@ -450,3 +563,29 @@ func (h *Handlers) SendTestNotification() error {
 	defer cancel()
 	return h.notificationSvc.SendTestNotification(ctx)
 }
+
+// SendNotificationDigest emails the daily digest immediately
+func (h *Handlers) SendNotificationDigest() error {
+	if h.notificationSvc == nil {
+		return fmt.Errorf("notification service not initialized")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	return h.notificationSvc.SendDigest(ctx)
+}
+
+// PreviewNotificationTemplate renders a notification template against sample data
+func (h *Handlers) PreviewNotificationTemplate(tmpl domain.NotificationTemplate) (string, error) {
+	if h.notificationSvc == nil {
+		return "", fmt.Errorf("notification service not initialized")
+	}
+	return h.notificationSvc.PreviewTemplate(tmpl)
+}
+
+// GetDefaultNotificationTemplates returns the built-in notification templates
+func (h *Handlers) GetDefaultNotificationTemplates() []domain.NotificationTemplate {
+	if h.notificationSvc == nil {
+		return nil
+	}
+	return h.notificationSvc.GetDefaultTemplates()
+}
@@ -7,6 +7,9 @@ type ActivityLogger interface {
 	// Log adds a new activity entry
 	Log(accountID string, actType domain.ActivityType, level domain.ActivityLevel, message string, details string)
 
+	// LogEntry adds a prepared entry; ID and Timestamp are filled in
+	LogEntry(entry domain.ActivityLog)
+
 	// GetLogs retrieves logs for an account (newest first)
 	GetLogs(accountID string, limit int) []domain.ActivityLog
 
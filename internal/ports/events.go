@@ -30,6 +30,13 @@ const (
 	EventReplyFailed    = "reply:failed"
 	EventReplyApproved  = "reply:approved"
 	EventReplyRejected  = "reply:rejected"
+	EventReplyScheduled = "reply:scheduled"
+	EventReplyDeleted   = "reply:deleted"
+
+	// Scheduled post events
+	EventPostScheduled = "post:scheduled"
+	EventPostPublished = "post:published"
+	EventPostFailed    = "post:failed"
 
 	// Approval queue events
 	EventApprovalRequired = "approval:required"
@@ -54,6 +61,9 @@ const (
 
 	// Polymarket events
 	EventPolymarketEvent = "polymarket:event"
+
+	// Notification events
+	EventDesktopNotification = "notification:desktop"
 )
 
 // TweetFoundEvent payload
@@ -87,3 +97,12 @@ type WorkerStatusEvent struct {
 	LastActivity string `json:"lastActivity"`
 	NextRun      string `json:"nextRun,omitempty"`
 }
+
+// DesktopNotificationEvent payload for native desktop notifications
+type DesktopNotificationEvent struct {
+	EventType string `json:"eventType"`
+	Title     string `json:"title"`
+	Body      string `json:"body"`
+	Link      string `json:"link,omitempty"`
+	Priority  string `json:"priority"`
+}
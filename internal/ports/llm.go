@@ -10,6 +10,15 @@ type LLMProvider interface {
 	// GenerateReply creates a reply for a tweet
 	GenerateReply(ctx context.Context, req ReplyRequest) (*ReplyResponse, error)
 
+	// GenerateTweet writes a standalone tweet from a prompt
+	GenerateTweet(ctx context.Context, req TweetRequest) (*ReplyResponse, error)
+
+	// ClassifyTweets scores how relevant each tweet is to a topic
+	ClassifyTweets(ctx context.Context, req ClassifyRequest) (*ClassifyResponse, error)
+
+	// ModerateReply judges whether a reply is safe to post
+	ModerateReply(ctx context.Context, req ModerationRequest) (*ModerationResponse, error)
+
 	// ValidateConfig checks if the LLM configuration is valid
 	ValidateConfig() error
 
@@ -23,13 +32,58 @@ type LLMProvider interface {
 // ReplyRequest contains the context needed to generate a reply
 type ReplyRequest struct {
 	OriginalTweet   domain.Tweet
-	ThreadContext   []domain.Tweet // Previous tweets in thread
+	ThreadContext   []domain.Tweet // Parent chain, the tweet itself and top replies
 	AuthorBio       string         // Tweet author's bio
 	AccountPersona  string         // Replying account's persona
 	Keywords        []string       // Matched keywords for context
 	MaxLength       int            // Maximum reply length
 	Tone            string         // "professional", "casual", "witty"
 	IncludeHashtags bool
+	Instructions    string  // Extra direction from the account's experiment variant
+	Style           string  // Extra direction for this variant (reply candidates)
+	Temperature     float64 // Overrides the configured temperature when > 0
+}
+
+// TweetRequest contains the context needed to write a standalone tweet
+type TweetRequest struct {
+	Prompt          string // What the tweet should be about
+	AccountPersona  string // Posting account's persona
+	MaxLength       int    // Maximum tweet length
+	Tone            string // "professional", "casual", "witty"
+	IncludeHashtags bool
+}
+
+// ClassifyRequest asks for the relevance of tweets to an account's topic
+type ClassifyRequest struct {
+	Topic  string // What the account wants to reply about
+	Tweets []domain.Tweet
+}
+
+// ClassifyResponse holds one classification per classified tweet
+type ClassifyResponse struct {
+	Results    []TweetClassification
+	TokensUsed int
+}
+
+// TweetClassification is the LLM's judgement of one tweet
+type TweetClassification struct {
+	TweetID   string
+	Relevance float64 // 0 (off-topic) to 1 (squarely on topic)
+	Sentiment string  // "positive", "neutral" or "negative"
+	Reason    string  // Short explanation of the score
+}
+
+// ModerationRequest asks whether a reply is safe to post
+type ModerationRequest struct {
+	Text          string
+	OriginalTweet domain.Tweet // Tweet being replied to; empty for standalone tweets
+}
+
+// ModerationResponse is the LLM's moderation verdict
+type ModerationResponse struct {
+	Flagged    bool
+	Reason     string // Why the reply was flagged
+	TokensUsed int
 }
 
 // ReplyResponse contains the generated reply
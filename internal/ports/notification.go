@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"xtools/internal/domain"
 )
@@ -34,4 +35,8 @@ type NotificationStore interface {
 
 	// MarkNotified marks an item as notified
 	MarkNotified(itemType, itemID string) error
+
+	// Digest data
+	GetEvents(filter domain.PolymarketEventFilter) ([]domain.PolymarketEvent, error)
+	GetFreshWalletsSince(since time.Time, limit int) ([]domain.WalletProfile, error)
 }
@@ -2,6 +2,8 @@ package ports
 
 import (
 	"context"
+	"time"
+
 	"xtools/internal/domain"
 )
 
@@ -43,6 +45,8 @@ type MetricsStore interface {
 	// Reply metrics
 	SaveReplyMetrics(metrics domain.ReplyMetrics) error
 	GetReplyPerformance(accountID string, days int) (*domain.ReplyPerformanceReport, error)
+	GetTopReplies(accountID string, since time.Time, limit int) ([]domain.ReplyMetrics, error)
+	LastReplyMetricsAt(replyID string) (time.Time, error)
 
 	// Daily stats
 	SaveDailyStats(stats domain.DailyStats) error
@@ -52,10 +56,21 @@ type MetricsStore interface {
 	IsReplied(accountID, tweetID string) (bool, error)
 	MarkReplied(accountID, tweetID, replyID string) error
 
+	// Incremental search cursors (newest tweet ID seen per account and query)
+	GetSearchCursor(accountID, queryKey string) (string, error)
+	SaveSearchCursor(accountID, queryKey, sinceID string) error
+
 	// Cleanup
 	Close() error
 }
 
+// KeywordStatsStore tracks how search keywords convert into replies
+type KeywordStatsStore interface {
+	RecordTweetsFound(accountID string, counts map[string]int) error
+	RecordReplyKeywords(accountID, replyID string, keywords []string) error
+	GetKeywordStats(accountID string, days int) ([]domain.KeywordStats, error)
+}
+
 // ExcelExporter exports data to Excel files
 type ExcelExporter interface {
 	// Export tweets found for an account
@@ -83,10 +98,46 @@ type ReplyStore interface {
 	AddPendingReply(item domain.ApprovalQueueItem) error
 	GetPendingReplies(accountID string) ([]domain.ApprovalQueueItem, error)
 	UpdateReplyStatus(replyID string, status domain.ReplyStatus) error
+	UpdatePendingReply(reply domain.Reply) error
 	RemovePendingReply(replyID string) error
+	ExpirePendingReplies(now time.Time) ([]domain.Reply, error)
+	SaveCandidateChoice(reply domain.Reply, chosen int) error
+	IsReplyQueued(accountID, tweetID string) (bool, error)
 
 	// Reply history
 	SaveReply(reply domain.Reply) error
 	GetReplies(accountID string, limit int) ([]domain.Reply, error)
+	GetReplyHistory(accountID string, limit int) ([]domain.Reply, error) // With the tweets replied to
 	GetReplyByID(replyID string) (*domain.Reply, error)
+	GetReplyByPostedID(postedReplyID string) (*domain.Reply, error)
+	GetPostedRepliesSince(since time.Time) ([]domain.Reply, error)
+
+	// Tweet context; the first save of a tweet is kept
+	SaveTweets(tweets []domain.Tweet) error
+	GetTweet(tweetID string) (*domain.Tweet, error)
+
+	// Posting queue
+	GetDueReplies(now time.Time) ([]domain.Reply, error)
+	ClaimScheduledReply(replyID string) (bool, error)
+	FailInterruptedReplies() (int, error)
+	LastReplySlot(accountID string) (time.Time, error)
+
+	// Reporting
+	GetReplyStats(since time.Time) ([]domain.AccountReplyStats, error)
+	CountRepliesSince(accountID string, replyType domain.ReplyType, since time.Time) (int, error)
+	CountPostedRepliesByAuthor(accountID string, authors []string) (map[string]int, error)
+	GetReplyOutcomes(accountID string, minAge, maxAge time.Duration) ([]domain.ReplyOutcome, error)
+}
+
+// ScheduledPostStore manages the queue of scheduled original posts
+type ScheduledPostStore interface {
+	SavePost(post domain.ScheduledPost) error
+	GetPost(postID string) (*domain.ScheduledPost, error)
+	GetPosts(accountID string, limit int) ([]domain.ScheduledPost, error)
+	DeletePost(postID string) error
+
+	// Publishing
+	GetDuePosts(now time.Time) ([]domain.ScheduledPost, error)
+	ClaimPost(postID string) (bool, error)
+	FailInterruptedPosts() (int, error)
 }
@@ -2,6 +2,8 @@ package ports
 
 import (
 	"context"
+	"time"
+
 	"xtools/internal/domain"
 )
 
@@ -18,13 +20,18 @@ type TwitterClient interface {
 	// Tweet Operations
 	GetTweet(ctx context.Context, tweetID string) (*domain.Tweet, error)
 	GetTweetThread(ctx context.Context, tweetID string) ([]domain.Tweet, error)
-	PostReply(ctx context.Context, tweetID string, text string) (*domain.Reply, error)
+	PostReply(ctx context.Context, tweetID string, text string, media ...domain.MediaAttachment) (*domain.Reply, error)
+	PostTweet(ctx context.Context, text string, media ...domain.MediaAttachment) (*domain.Tweet, error)
+	PostQuoteTweet(ctx context.Context, tweetID string, text string) (*domain.Tweet, error)
+	PostThread(ctx context.Context, texts []string) ([]domain.Tweet, error)
+	DeleteTweet(ctx context.Context, tweetID string) error
 
 	// Profile & Metrics
 	GetProfile(ctx context.Context) (*domain.User, error)
 	GetUser(ctx context.Context, username string) (*domain.User, error)
 	GetTweetMetrics(ctx context.Context, tweetID string) (*domain.TweetMetrics, error)
 	GetMyTweets(ctx context.Context, opts PaginationOptions) ([]domain.Tweet, error)
+	GetMentions(ctx context.Context, opts MentionsOptions) ([]domain.Tweet, error)
 
 	// Rate Limiting
 	GetRateLimitStatus() *domain.RateLimitStatus
@@ -36,13 +43,22 @@ type TwitterClient interface {
 // SearchOptions configures tweet search behavior
 type SearchOptions struct {
 	MaxResults     int
-	SinceID        string
+	SinceID        string // Only return tweets newer than this ID
+	NextToken      string // Page token from a previous SearchResult
 	Lang           string // "en" for English-only
 	ExcludeReplies bool
 	ExcludeRetweets bool
 	MinLikes       int
 	MinRetweets    int
 	SortByViews    bool // prioritize high-view tweets
+	StartTime      time.Time // API only: oldest creation time (zero = no limit)
+	EndTime        time.Time // API only: newest creation time (zero = no limit)
+}
+
+// MentionsOptions configures mentions timeline requests
+type MentionsOptions struct {
+	MaxResults int
+	SinceID    string // Only return mentions newer than this ID
 }
 
 // PaginationOptions configures paginated requests
@@ -2,12 +2,17 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 
+	"xtools/internal/adapters/notification"
+	"xtools/internal/adapters/twitter"
 	"xtools/internal/domain"
 	"xtools/internal/ports"
 )
@@ -144,11 +149,7 @@ func (s *AccountService) createAndAuthClient(accountID string) (ports.TwitterCli
 
 	if err := client.Authenticate(ctx); err != nil {
 		client.Close()
-		s.eventBus.Emit(ports.EventAccountError, ports.AccountStatusEvent{
-			AccountID: accountID,
-			Status:    "auth_failed",
-			Error:     err.Error(),
-		})
+		s.emitAuthError(accountID, err)
 		return nil, fmt.Errorf("authentication failed: %w", err)
 	}
 
@@ -159,6 +160,20 @@ func (s *AccountService) createAndAuthClient(accountID string) (ports.TwitterCli
 	return client, nil
 }
 
+// emitAuthError reports an authentication failure, flagging expired cookies
+func (s *AccountService) emitAuthError(accountID string, err error) {
+	status := domain.AccountErrorAuthFailed
+	if errors.Is(err, domain.ErrCookiesExpired) {
+		status = domain.AccountErrorCookiesExpired
+	}
+
+	s.eventBus.Emit(ports.EventAccountError, ports.AccountStatusEvent{
+		AccountID: accountID,
+		Status:    status,
+		Error:     err.Error(),
+	})
+}
+
 func (s *AccountService) closeClient(accountID string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -261,12 +276,26 @@ func (s *AccountService) GetBrowserClientForPosting(accountID string) (ports.Twi
 
 	if err := client.Authenticate(ctx); err != nil {
 		client.Close()
+		s.emitAuthError(accountID, err)
 		return nil, fmt.Errorf("browser authentication failed: %w", err)
 	}
 
 	return client, nil
 }
 
+// GetPostingClient returns a client for posting using the account's reply method
+func (s *AccountService) GetPostingClient(accountID string) (ports.TwitterClient, error) {
+	cfg, err := s.configStore.LoadAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.ReplyConfig.ReplyMethod == domain.ReplyMethodBrowser {
+		return s.GetBrowserClientForPosting(accountID)
+	}
+	return s.GetAPIClientForPosting(accountID)
+}
+
 // Close closes all clients
 func (s *AccountService) Close() {
 	s.mu.Lock()
@@ -320,5 +349,124 @@ func (s *AccountService) validateConfig(cfg domain.AccountConfig) error {
 		return fmt.Errorf("LLM API key is required")
 	}
 
+	if cfg.SearchConfig.MaxPages < 0 || cfg.SearchConfig.MaxPages > 10 {
+		return fmt.Errorf("max pages per search must be between 0 and 10 (0 = 1 page)")
+	}
+
+	if cfg.SearchConfig.MinAuthorScore < 0 || cfg.SearchConfig.MinAuthorScore > 100 {
+		return fmt.Errorf("minimum author score must be between 0 and 100")
+	}
+
+	if err := validateSearchQuery(&cfg); err != nil {
+		return err
+	}
+
+	for _, group := range cfg.SearchConfig.KeywordGroups {
+		if strings.TrimSpace(strings.Join(group.Keywords, "")) == "" {
+			return fmt.Errorf("keyword group %q has no keywords", group.Name)
+		}
+		if group.IntervalSecs < 0 {
+			return fmt.Errorf("keyword group %q interval must not be negative", group.Name)
+		}
+		if f := group.Filters; f != nil && (f.MinFaves < 0 || f.MinReplies < 0 || f.MinRetweets < 0 || f.MaxAgeMins < 0) {
+			return fmt.Errorf("keyword group %q filters must not be negative", group.Name)
+		}
+	}
+
+	if cfg.ReplyConfig.Candidates < 0 || cfg.ReplyConfig.Candidates > maxReplyCandidates {
+		return fmt.Errorf("reply candidates must be between 0 and %d", maxReplyCandidates)
+	}
+	if cfg.ReplyConfig.UndoWindowSecs < 0 {
+		return fmt.Errorf("undo window must not be negative")
+	}
+
+	if cfg.Posting.ActiveStart < 0 || cfg.Posting.ActiveStart > 23 || cfg.Posting.ActiveEnd < 0 || cfg.Posting.ActiveEnd > 23 {
+		return fmt.Errorf("active hours must be between 0 and 23")
+	}
+	if cfg.Posting.JitterSecs < 0 {
+		return fmt.Errorf("posting jitter must not be negative")
+	}
+	if _, err := cfg.Posting.Location(); err != nil {
+		return fmt.Errorf("invalid timezone %q: use a name such as America/New_York", cfg.Posting.Timezone)
+	}
+
+	if cfg.Relevance.Enabled {
+		if strings.TrimSpace(cfg.Relevance.Topic) == "" {
+			return fmt.Errorf("relevance filter needs a topic description")
+		}
+		if cfg.Relevance.MinScore < 0 || cfg.Relevance.MinScore > 1 {
+			return fmt.Errorf("relevance minimum score must be between 0 and 1")
+		}
+	}
+
+	if cfg.Guardrails.MaxHashtags < 0 {
+		return fmt.Errorf("max hashtags must not be negative")
+	}
+	if cfg.Guardrails.DuplicateThreshold < 0 || cfg.Guardrails.DuplicateThreshold > 1 {
+		return fmt.Errorf("duplicate threshold must be between 0 and 1")
+	}
+	for _, pattern := range cfg.Guardrails.BannedPatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid banned pattern %q: %w", pattern, err)
+		}
+	}
+
+	if cfg.Experiment.Enabled {
+		if len(cfg.Experiment.Variants) < 2 {
+			return fmt.Errorf("an experiment needs at least two variants")
+		}
+		names := make(map[string]bool)
+		totalWeight := 0
+		for _, v := range cfg.Experiment.Variants {
+			name := strings.TrimSpace(v.Name)
+			if name == "" {
+				return fmt.Errorf("experiment variants need a name")
+			}
+			if names[name] {
+				return fmt.Errorf("experiment variant %q is defined twice", name)
+			}
+			names[name] = true
+			if v.Weight < 0 {
+				return fmt.Errorf("experiment variant %q weight must not be negative", name)
+			}
+			totalWeight += v.Weight
+		}
+		if totalWeight == 0 {
+			return fmt.Errorf("experiment variants need a positive weight")
+		}
+	}
+
+	if cfg.FollowUps.Enabled && cfg.FollowUps.MaxDepth < 1 {
+		return fmt.Errorf("follow-up max depth must be at least 1")
+	}
+	if cfg.FollowUps.IntervalSecs < 0 {
+		return fmt.Errorf("mentions check interval must not be negative")
+	}
+
+	if cfg.AlertBridge.Enabled {
+		if cfg.AlertBridge.MinTradeValue < 0 || cfg.AlertBridge.DailyCap < 0 {
+			return fmt.Errorf("alert bridge trade value and daily cap must not be negative")
+		}
+		if err := notification.ValidateAlertPrompt(cfg.AlertBridge.Template); err != nil {
+			return fmt.Errorf("invalid alert bridge template: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// validateSearchQuery checks the structured query and, for API search, that the
+// rendered query fits the endpoint's length limit
+func validateSearchQuery(cfg *domain.AccountConfig) error {
+	if err := cfg.SearchConfig.Query.Validate(); err != nil {
+		return fmt.Errorf("invalid search query: %w", err)
+	}
+
+	if cfg.AuthType == domain.AuthTypeAPI {
+		query := twitter.BuildAPIQuery(cfg.SearchConfig.QueryFor(cfg.SearchConfig.Keywords))
+		if len(query) > twitter.MaxAPIQueryLength {
+			return fmt.Errorf("search query is %d characters, the API allows %d", len(query), twitter.MaxAPIQueryLength)
+		}
+	}
 	return nil
 }
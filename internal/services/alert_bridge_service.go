@@ -0,0 +1,332 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"xtools/internal/adapters/notification"
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+// Big trades wait this long for their wallet to be analyzed
+const alertTradeTTL = time.Hour
+
+// AlertBridgeService turns fresh-wallet Polymarket trades into tweets drafted
+// from accounts that have the alert bridge enabled
+type AlertBridgeService struct {
+	accountSvc     *AccountService
+	replySvc       *ReplyService
+	llmFactory     ports.LLMProviderFactory
+	replyStore     ports.ReplyStore
+	metricsStore   ports.MetricsStore
+	eventBus       ports.EventBus
+	activityLogger ports.ActivityLogger
+
+	mu          sync.Mutex
+	pending     map[string][]domain.PolymarketEvent // wallet -> big trades awaiting wallet analysis
+	unsubscribe []func()
+
+	draftMu sync.Mutex // serializes the dedupe and daily cap checks with queuing
+}
+
+// NewAlertBridgeService creates a new alert bridge service
+func NewAlertBridgeService(
+	accountSvc *AccountService,
+	replySvc *ReplyService,
+	llmFactory ports.LLMProviderFactory,
+	replyStore ports.ReplyStore,
+	metricsStore ports.MetricsStore,
+	eventBus ports.EventBus,
+	activityLogger ports.ActivityLogger,
+) *AlertBridgeService {
+	return &AlertBridgeService{
+		accountSvc:     accountSvc,
+		replySvc:       replySvc,
+		llmFactory:     llmFactory,
+		replyStore:     replyStore,
+		metricsStore:   metricsStore,
+		eventBus:       eventBus,
+		activityLogger: activityLogger,
+		pending:        make(map[string][]domain.PolymarketEvent),
+	}
+}
+
+// Start subscribes to Polymarket events
+func (s *AlertBridgeService) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.unsubscribe != nil {
+		return // Already running
+	}
+	s.unsubscribe = []func(){
+		s.eventBus.Subscribe(ports.EventPolymarketEvent, s.handleTrade),
+		s.eventBus.Subscribe("polymarket:fresh_wallet_detected", s.handleFreshWallet),
+	}
+}
+
+// Stop unsubscribes from Polymarket events and drops held trades
+func (s *AlertBridgeService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, unsubscribe := range s.unsubscribe {
+		unsubscribe()
+	}
+	s.unsubscribe = nil
+	s.pending = make(map[string][]domain.PolymarketEvent)
+}
+
+// handleTrade drafts alerts for trades from known fresh wallets and holds
+// other big trades until their wallet has been analyzed
+func (s *AlertBridgeService) handleTrade(data interface{}) {
+	event, ok := data.(domain.PolymarketEvent)
+	if !ok || event.EventType != domain.PolymarketEventTrade || event.WalletAddress == "" {
+		return
+	}
+
+	accounts := s.bridgeAccounts()
+	if len(accounts) == 0 {
+		return
+	}
+
+	notional := parseNotionalValue(event.Price, event.Size)
+	minValue := accounts[0].AlertBridge.MinTradeValue
+	for _, cfg := range accounts[1:] {
+		if cfg.AlertBridge.MinTradeValue < minValue {
+			minValue = cfg.AlertBridge.MinTradeValue
+		}
+	}
+	if notional < minValue {
+		return
+	}
+
+	if event.IsFreshWallet {
+		s.draftForAccounts(accounts, event)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cutoff := time.Now().Add(-alertTradeTTL)
+	for wallet, trades := range s.pending {
+		if len(trades) > 0 && trades[len(trades)-1].Timestamp.Before(cutoff) {
+			delete(s.pending, wallet)
+		}
+	}
+	s.pending[event.WalletAddress] = append(s.pending[event.WalletAddress], event)
+}
+
+// handleFreshWallet drafts alerts for held trades once their wallet is found to be fresh
+func (s *AlertBridgeService) handleFreshWallet(data interface{}) {
+	profile, ok := data.(domain.WalletProfile)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	trades := s.pending[profile.Address]
+	delete(s.pending, profile.Address)
+	s.mu.Unlock()
+
+	if len(trades) == 0 {
+		return
+	}
+
+	accounts := s.bridgeAccounts()
+	cutoff := time.Now().Add(-alertTradeTTL)
+	for _, event := range trades {
+		if event.Timestamp.Before(cutoff) {
+			continue
+		}
+		event.IsFreshWallet = true
+		event.WalletProfile = &profile
+		s.draftForAccounts(accounts, event)
+	}
+}
+
+// bridgeAccounts returns enabled accounts with the alert bridge turned on
+func (s *AlertBridgeService) bridgeAccounts() []domain.AccountConfig {
+	accounts, err := s.accountSvc.ListAccounts()
+	if err != nil {
+		return nil
+	}
+
+	var result []domain.AccountConfig
+	for _, cfg := range accounts {
+		if cfg.Enabled && cfg.AlertBridge.Enabled {
+			result = append(result, cfg)
+		}
+	}
+	return result
+}
+
+// draftForAccounts drafts an alert tweet from each account whose threshold the trade meets
+func (s *AlertBridgeService) draftForAccounts(accounts []domain.AccountConfig, event domain.PolymarketEvent) {
+	notional := parseNotionalValue(event.Price, event.Size)
+	for _, cfg := range accounts {
+		if notional < cfg.AlertBridge.MinTradeValue {
+			continue
+		}
+		if err := s.draftAlert(cfg, event); err != nil {
+			s.log(cfg.ID, domain.ActivityLevelError, "Failed to draft alert tweet", err.Error())
+		}
+	}
+}
+
+// draftAlert generates an alert tweet and queues or schedules it per the account's approval mode
+func (s *AlertBridgeService) draftAlert(cfg domain.AccountConfig, event domain.PolymarketEvent) error {
+	// Use the trade as the reply's source item for deduplication
+	tradeID := event.TradeID
+	if tradeID == "" {
+		tradeID = event.WalletAddress + "_" + event.Timestamp.Format(time.RFC3339Nano)
+	}
+	sourceID := "polymarket:" + tradeID
+
+	// Check before paying for generation; checked again under the lock below
+	if ok, err := s.canDraft(cfg, sourceID); !ok {
+		return err
+	}
+
+	prompt, err := notification.RenderAlertPrompt(cfg.AlertBridge.Template, event)
+	if err != nil {
+		return fmt.Errorf("failed to render alert template: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	llm, err := s.llmFactory.CreateProvider(cfg.LLMConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create LLM provider: %w", err)
+	}
+	defer llm.Close()
+
+	resp, err := llm.GenerateTweet(ctx, ports.TweetRequest{
+		Prompt:          prompt,
+		AccountPersona:  cfg.LLMConfig.Persona,
+		MaxLength:       cfg.ReplyConfig.MaxReplyLength,
+		Tone:            cfg.ReplyConfig.Tone,
+		IncludeHashtags: cfg.ReplyConfig.IncludeHashtags,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to generate alert tweet: %w", err)
+	}
+
+	s.log(cfg.ID, domain.ActivityLevelSuccess, "Alert tweet generated", truncate(resp.Text, 100))
+
+	reply := domain.Reply{
+		ID:            uuid.New().String(),
+		TweetID:       sourceID,
+		AccountID:     cfg.ID,
+		Text:          resp.Text,
+		GeneratedAt:   time.Now(),
+		Status:        domain.ReplyStatusPending,
+		LLMTokensUsed: resp.TokensUsed,
+		Type:          domain.ReplyTypeTweet,
+	}
+
+	s.eventBus.Emit(ports.EventReplyGenerated, ports.ReplyEvent{
+		AccountID: cfg.ID,
+		Reply:     &reply,
+		TweetID:   sourceID,
+	})
+
+	source := alertSourceTweet(sourceID, event)
+	reply.Violations = s.replySvc.CheckGuardrails(ctx, &cfg, reply, domain.Tweet{})
+
+	// Another draft of this trade, or enough others to reach the cap, may have
+	// been queued while this one was generating
+	s.draftMu.Lock()
+	defer s.draftMu.Unlock()
+	if ok, err := s.canDraft(cfg, sourceID); !ok {
+		return err
+	}
+
+	if cfg.DebugMode || cfg.ReplyConfig.ApprovalMode != domain.ApprovalModeAuto {
+		s.log(cfg.ID, domain.ActivityLevelInfo, "Queuing alert tweet for approval", truncate(reply.Text, 50))
+		return s.replySvc.QueueReply(reply, source)
+	}
+	if len(reply.Violations) > 0 {
+		s.log(cfg.ID, domain.ActivityLevelInfo, "Queuing alert tweet for approval (failed guardrails)", truncate(reply.Text, 50))
+		return s.replySvc.QueueReply(reply, source)
+	}
+
+	s.log(cfg.ID, domain.ActivityLevelInfo, "Scheduling alert tweet (auto mode)", "")
+	return s.replySvc.ScheduleReply(reply)
+}
+
+// canDraft reports whether the account may queue an alert for the trade: it has
+// not already posted or queued one, and is under its daily cap
+func (s *AlertBridgeService) canDraft(cfg domain.AccountConfig, sourceID string) (bool, error) {
+	if replied, _ := s.metricsStore.IsReplied(cfg.ID, sourceID); replied {
+		return false, nil
+	}
+	if queued, err := s.replyStore.IsReplyQueued(cfg.ID, sourceID); err != nil {
+		return false, fmt.Errorf("failed to check queued alert tweets: %w", err)
+	} else if queued {
+		return false, nil
+	}
+
+	if cfg.AlertBridge.DailyCap > 0 {
+		now := time.Now()
+		startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		count, err := s.replyStore.CountRepliesSince(cfg.ID, domain.ReplyTypeTweet, startOfDay)
+		if err != nil {
+			return false, fmt.Errorf("failed to count alert tweets: %w", err)
+		}
+		if count >= cfg.AlertBridge.DailyCap {
+			s.log(cfg.ID, domain.ActivityLevelWarning, "Alert tweet daily cap reached", fmt.Sprintf("%d/%d", count, cfg.AlertBridge.DailyCap))
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// alertSourceTweet describes the triggering trade for the approval queue
+func alertSourceTweet(sourceID string, event domain.PolymarketEvent) domain.Tweet {
+	market := event.EventTitle
+	if market == "" {
+		market = event.MarketName
+	}
+	side := "BUY"
+	if event.Side == domain.OrderSideSell {
+		side = "SELL"
+	}
+
+	text := fmt.Sprintf("Fresh wallet %s %s $%.2f on %s", shortenAddress(event.WalletAddress), side,
+		parseNotionalValue(event.Price, event.Size), market)
+	if event.Outcome != "" {
+		text += " (" + event.Outcome + ")"
+	}
+
+	link := event.MarketLink
+	if link == "" && event.EventSlug != "" {
+		link = "https://polymarket.com/event/" + event.EventSlug
+	}
+	if link != "" {
+		text += "\n" + link
+	}
+
+	return domain.Tweet{
+		ID:             sourceID,
+		Text:           text,
+		AuthorUsername: "polymarket",
+		AuthorName:     "Polymarket",
+		CreatedAt:      event.Timestamp,
+		DiscoveredAt:   time.Now(),
+	}
+}
+
+// log logs an activity if logger is available
+func (s *AlertBridgeService) log(accountID string, level domain.ActivityLevel, message, details string) {
+	if s.activityLogger != nil {
+		s.activityLogger.Log(accountID, domain.ActivityTypeReply, level, message, details)
+	}
+}
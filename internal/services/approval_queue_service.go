@@ -0,0 +1,231 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+// How often the approval queue is swept for expired items
+const approvalSweepInterval = time.Minute
+
+// ApprovalQueueService expires stale approval queue items and works on the queue
+// across accounts
+type ApprovalQueueService struct {
+	replySvc       *ReplyService
+	replyStore     ports.ReplyStore
+	eventBus       ports.EventBus
+	activityLogger ports.ActivityLogger
+
+	mu     sync.Mutex
+	stopCh chan struct{}
+}
+
+// NewApprovalQueueService creates a new approval queue service
+func NewApprovalQueueService(
+	replySvc *ReplyService,
+	replyStore ports.ReplyStore,
+	eventBus ports.EventBus,
+	activityLogger ports.ActivityLogger,
+) *ApprovalQueueService {
+	return &ApprovalQueueService{
+		replySvc:       replySvc,
+		replyStore:     replyStore,
+		eventBus:       eventBus,
+		activityLogger: activityLogger,
+	}
+}
+
+// Start begins expiring stale queue items in the background
+func (s *ApprovalQueueService) Start() {
+	s.mu.Lock()
+	if s.stopCh != nil {
+		s.mu.Unlock()
+		return // Already running
+	}
+	s.stopCh = make(chan struct{})
+	stopCh := s.stopCh
+	s.mu.Unlock()
+
+	go s.run(stopCh)
+}
+
+// Stop stops the background sweeper
+func (s *ApprovalQueueService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopCh != nil {
+		close(s.stopCh)
+		s.stopCh = nil
+	}
+}
+
+func (s *ApprovalQueueService) run(stopCh chan struct{}) {
+	// Items may have expired while the app was closed
+	s.sweep()
+
+	ticker := time.NewTicker(approvalSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			s.sweep()
+		}
+	}
+}
+
+// sweep marks every queue item past its expiry as expired
+func (s *ApprovalQueueService) sweep() {
+	expired, err := s.replyStore.ExpirePendingReplies(time.Now())
+	if err != nil {
+		log.Printf("[ApprovalQueueService] Failed to expire pending replies: %v", err)
+		return
+	}
+	if len(expired) == 0 {
+		return
+	}
+
+	perAccount := make(map[string]int)
+	for _, reply := range expired {
+		perAccount[reply.AccountID]++
+	}
+	for accountID, n := range perAccount {
+		if s.activityLogger != nil {
+			s.activityLogger.Log(accountID, domain.ActivityTypeReply, domain.ActivityLevelInfo,
+				fmt.Sprintf("%d pending repl%s expired without approval", n, pluralY(n)), "")
+		}
+	}
+
+	s.eventBus.Emit(ports.EventApprovalQueueUpdated, expired)
+}
+
+func pluralY(n int) string {
+	if n == 1 {
+		return "y"
+	}
+	return "ies"
+}
+
+// GetAllPendingReplies returns approval queue items across accounts, filtered and sorted
+func (s *ApprovalQueueService) GetAllPendingReplies(filter domain.PendingFilter) ([]domain.ApprovalQueueItem, error) {
+	items, err := s.replyStore.GetPendingReplies("")
+	if err != nil {
+		return nil, err
+	}
+	setQueuePriority(items, time.Now())
+
+	search := strings.ToLower(strings.TrimSpace(filter.Search))
+	filtered := make([]domain.ApprovalQueueItem, 0, len(items))
+	for _, item := range items {
+		if len(filter.AccountIDs) > 0 && !containsString(filter.AccountIDs, item.Reply.AccountID) {
+			continue
+		}
+		if item.Priority < filter.MinPriority {
+			continue
+		}
+		if search != "" &&
+			!strings.Contains(strings.ToLower(item.Reply.Text), search) &&
+			!strings.Contains(strings.ToLower(item.OriginalTweet.Text), search) &&
+			!strings.Contains(strings.ToLower(item.OriginalTweet.AuthorUsername), search) {
+			continue
+		}
+		filtered = append(filtered, item)
+	}
+
+	sortPendingReplies(filtered, filter.SortBy)
+	return filtered, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// sortPendingReplies orders queue items; priority order is the default
+func sortPendingReplies(items []domain.ApprovalQueueItem, by domain.PendingSort) {
+	var less func(a, b domain.ApprovalQueueItem) bool
+	switch by {
+	case domain.PendingSortQueued:
+		less = func(a, b domain.ApprovalQueueItem) bool { return a.QueuedAt.Before(b.QueuedAt) }
+	case domain.PendingSortExpires:
+		less = func(a, b domain.ApprovalQueueItem) bool { return a.ExpiresAt.Before(b.ExpiresAt) }
+	case domain.PendingSortViews:
+		less = func(a, b domain.ApprovalQueueItem) bool { return a.OriginalTweet.ViewCount > b.OriginalTweet.ViewCount }
+	case domain.PendingSortRelevance:
+		relevance := func(item domain.ApprovalQueueItem) float64 {
+			if item.OriginalTweet.Relevance == nil {
+				return -1
+			}
+			return *item.OriginalTweet.Relevance
+		}
+		less = func(a, b domain.ApprovalQueueItem) bool { return relevance(a) > relevance(b) }
+	default:
+		less = func(a, b domain.ApprovalQueueItem) bool { return a.Priority > b.Priority }
+	}
+	sort.SliceStable(items, func(i, j int) bool { return less(items[i], items[j]) })
+}
+
+// setQueuePriority scores each item from 0 to 100. Views and author followers
+// count on a log scale; fresh tweets score higher as replies to old tweets get
+// little reach.
+func setQueuePriority(items []domain.ApprovalQueueItem, now time.Time) {
+	logScale := func(n int) float64 {
+		// 1M and above scores 1
+		return math.Min(math.Log10(float64(n)+1)/6, 1)
+	}
+
+	for i := range items {
+		tweet := items[i].OriginalTweet
+
+		posted := tweet.CreatedAt
+		if posted.IsZero() {
+			posted = items[i].QueuedAt
+		}
+		freshness := 1 - now.Sub(posted).Hours()/24
+		freshness = math.Max(0, math.Min(freshness, 1))
+
+		score := 40*logScale(tweet.ViewCount) + 30*logScale(tweet.AuthorFollowers) + 30*freshness
+		items[i].Priority = math.Round(score*10) / 10
+	}
+}
+
+// ApproveReplies approves several pending replies; the posting queue spaces them out
+func (s *ApprovalQueueService) ApproveReplies(replyIDs []string) domain.BulkResult {
+	result := domain.BulkResult{Failed: make(map[string]string)}
+	for _, id := range replyIDs {
+		if err := s.replySvc.ApproveReply(id); err != nil {
+			result.Failed[id] = err.Error()
+		} else {
+			result.Succeeded = append(result.Succeeded, id)
+		}
+	}
+	return result
+}
+
+// RejectReplies rejects several pending replies
+func (s *ApprovalQueueService) RejectReplies(replyIDs []string) domain.BulkResult {
+	result := domain.BulkResult{Failed: make(map[string]string)}
+	for _, id := range replyIDs {
+		if err := s.replySvc.RejectReply(id); err != nil {
+			result.Failed[id] = err.Error()
+		} else {
+			result.Succeeded = append(result.Succeeded, id)
+		}
+	}
+	return result
+}
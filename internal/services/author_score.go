@@ -0,0 +1,131 @@
+package services
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"xtools/internal/domain"
+)
+
+// neutralAuthorScore is the score of an author whose profile could not be read
+const neutralAuthorScore = 50
+
+var (
+	usernameRe       = regexp.MustCompile(`^[A-Za-z0-9_]{1,15}$`)
+	trailingDigitsRe = regexp.MustCompile(`\d{6,}$`)
+)
+
+// scoreAuthor rates how worthwhile replying to a tweet's author is, from 0 to 100.
+// It weighs reach, account age, verification, follower ratio, bot-like traits and
+// how often we have already replied to them.
+func scoreAuthor(tweet domain.Tweet, pastReplies int, now time.Time) int {
+	score := neutralAuthorScore
+
+	// Clients that could not read the profile leave these zero
+	if tweet.AuthorFollowers > 0 || tweet.AuthorFollowing > 0 || tweet.AuthorCreatedAt != nil {
+		// Reach
+		switch f := tweet.AuthorFollowers; {
+		case f >= 100000:
+			score += 20
+		case f >= 10000:
+			score += 15
+		case f >= 1000:
+			score += 10
+		case f < 50:
+			score -= 15
+		case f < 200:
+			score -= 5
+		}
+
+		// Account age
+		if tweet.AuthorCreatedAt != nil {
+			age := now.Sub(*tweet.AuthorCreatedAt)
+			switch {
+			case age < 30*24*time.Hour:
+				score -= 20
+			case age < 180*24*time.Hour:
+				score -= 10
+			case age > 2*365*24*time.Hour:
+				score += 10
+			}
+
+			// More than 100 posts a day on average is automation
+			if days := age.Hours() / 24; days >= 1 && float64(tweet.AuthorTweetCount)/days > 100 {
+				score -= 15
+			}
+		}
+
+		// Follow-back farms follow many and are followed by few
+		if tweet.AuthorFollowing >= 1000 && tweet.AuthorFollowers*10 < tweet.AuthorFollowing {
+			score -= 15
+		} else if tweet.AuthorFollowers >= tweet.AuthorFollowing {
+			score += 5
+		}
+
+		if tweet.AuthorDefaultImage {
+			score -= 10
+		}
+		if strings.TrimSpace(tweet.AuthorBio) == "" {
+			score -= 5
+		}
+	}
+
+	if tweet.AuthorVerified {
+		score += 10
+	}
+
+	// Generated handles such as john84729163
+	if trailingDigitsRe.MatchString(tweet.AuthorUsername) {
+		score -= 10
+	}
+
+	// Authors we have replied to before are an established relationship
+	if pastReplies > 3 {
+		pastReplies = 3
+	}
+	score += 5 * pastReplies
+
+	if score < 0 {
+		return 0
+	}
+	if score > 100 {
+		return 100
+	}
+	return score
+}
+
+// ParseUsernameList reads usernames from a text file (one per line, with or
+// without @) or a CSV export (username in the first column). Comment lines
+// starting with #, header rows and duplicates are skipped.
+func ParseUsernameList(r io.Reader) ([]string, error) {
+	var usernames []string
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		field := line
+		if i := strings.IndexAny(line, ",;\t"); i >= 0 {
+			field = line[:i]
+		}
+		name := strings.TrimPrefix(strings.Trim(strings.TrimSpace(field), `"'`), "@")
+
+		key := strings.ToLower(name)
+		if !usernameRe.MatchString(name) || usernameHeaders[key] || seen[key] {
+			continue
+		}
+		seen[key] = true
+		usernames = append(usernames, name)
+	}
+
+	return usernames, scanner.Err()
+}
+
+var usernameHeaders = map[string]bool{"username": true, "screen_name": true, "handle": true, "user": true}
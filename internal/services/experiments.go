@@ -0,0 +1,251 @@
+package services
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+// Measured replies each variant needs before its difference from the baseline is tested
+const minExperimentSample = 5
+
+// Variants are compared on the snapshot taken at the 24h checkpoint. A reply
+// whose 24h snapshot was missed (the app was closed until 72h) is left out
+// rather than compared at a different age.
+const (
+	experimentCheckpoint    = 24 * time.Hour
+	experimentCheckpointMax = 72 * time.Hour
+)
+
+// pickVariant chooses one of the account's experiment variants at random by
+// weight; nil when no experiment is running
+func pickVariant(exp domain.ExperimentConfig) *domain.ExperimentVariant {
+	if !exp.Enabled || len(exp.Variants) < 2 {
+		return nil
+	}
+
+	total := 0
+	for _, v := range exp.Variants {
+		total += max(v.Weight, 0)
+	}
+	if total == 0 {
+		return nil
+	}
+
+	n := rand.Intn(total)
+	for i, v := range exp.Variants {
+		n -= max(v.Weight, 0)
+		if n < 0 {
+			return &exp.Variants[i]
+		}
+	}
+	return nil
+}
+
+// applyVariant overrides the reply request with the variant's settings
+func applyVariant(req *ports.ReplyRequest, v *domain.ExperimentVariant) {
+	if v.Persona != "" {
+		req.AccountPersona = v.Persona
+	}
+	if v.Tone != "" {
+		req.Tone = v.Tone
+	}
+	req.Instructions = v.Instructions
+}
+
+// GetExperimentResults compares the engagement of the account's experiment
+// variants. The first configured variant is the baseline; variants removed
+// from the experiment but with tagged replies are listed last.
+func (s *ReplyService) GetExperimentResults(accountID string) (*domain.ExperimentResults, error) {
+	cfg, err := s.accountSvc.GetAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	outcomes, err := s.replyStore.GetReplyOutcomes(accountID, experimentCheckpoint, experimentCheckpointMax)
+	if err != nil {
+		return nil, err
+	}
+
+	byVariant := make(map[string][]domain.ReplyOutcome)
+	for _, o := range outcomes {
+		byVariant[o.Variant] = append(byVariant[o.Variant], o)
+	}
+
+	var names []string
+	weights := make(map[string]int)
+	for _, v := range cfg.Experiment.Variants {
+		if _, seen := weights[v.Name]; !seen {
+			names = append(names, v.Name)
+		}
+		weights[v.Name] = v.Weight
+	}
+	var removed []string
+	for name := range byVariant {
+		if _, ok := weights[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+	names = append(names, removed...)
+
+	results := &domain.ExperimentResults{
+		AccountID:       accountID,
+		Enabled:         cfg.Experiment.Enabled,
+		CheckpointHours: int(experimentCheckpoint / time.Hour),
+	}
+
+	var baseLikes []float64
+	for i, name := range names {
+		r := domain.VariantResult{Name: name, Weight: weights[name], PValue: 1}
+
+		var likes []float64
+		impressions := 0
+		for _, o := range byVariant[name] {
+			r.Generated++
+			if o.Status != domain.ReplyStatusPosted {
+				continue
+			}
+			r.Posted++
+			if o.Measured {
+				likes = append(likes, float64(o.LikeCount))
+				impressions += o.Impressions
+			}
+		}
+		r.Measured = len(likes)
+		if r.Measured > 0 {
+			r.AvgLikes = mean(likes)
+			r.AvgImpressions = float64(impressions) / float64(r.Measured)
+		}
+
+		if i == 0 {
+			baseLikes = likes
+			r.Significance = domain.SignificanceBaseline
+		} else {
+			if base := mean(baseLikes); base > 0 {
+				r.LikeLift = r.AvgLikes/base - 1
+			}
+			if len(likes) < minExperimentSample || len(baseLikes) < minExperimentSample {
+				r.Significance = domain.SignificanceTooFew
+			} else {
+				r.PValue = welchPValue(likes, baseLikes)
+				r.Significance = domain.SignificanceInconclusive
+				if r.PValue < 0.05 {
+					r.Significance = domain.SignificanceSignificant
+				}
+			}
+		}
+
+		results.Variants = append(results.Variants, r)
+	}
+
+	return results, nil
+}
+
+// welchPValue returns the two-sided p-value of the difference in means of two
+// samples from Welch's t-test, with Welch-Satterthwaite degrees of freedom
+func welchPValue(a, b []float64) float64 {
+	va, vb := variance(a)/float64(len(a)), variance(b)/float64(len(b))
+	se := math.Sqrt(va + vb)
+	diff := mean(a) - mean(b)
+	if se == 0 {
+		if diff == 0 {
+			return 1
+		}
+		return 0
+	}
+
+	t := diff / se
+	df := (va + vb) * (va + vb) / (va*va/float64(len(a)-1) + vb*vb/float64(len(b)-1))
+	// P(|T| > t) for Student's t with df degrees of freedom
+	return regIncBeta(df/2, 0.5, df/(df+t*t))
+}
+
+// regIncBeta returns the regularized incomplete beta function I_x(a, b)
+func regIncBeta(a, b, x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+
+	lbetaA, _ := math.Lgamma(a)
+	lbetaB, _ := math.Lgamma(b)
+	lbetaAB, _ := math.Lgamma(a + b)
+	front := math.Exp(lbetaAB - lbetaA - lbetaB + a*math.Log(x) + b*math.Log(1-x))
+
+	// The continued fraction converges quickly for x below the mean; use the
+	// symmetry I_x(a, b) = 1 - I_(1-x)(b, a) otherwise
+	if x > (a+1)/(a+b+2) {
+		return 1 - front*betaContinuedFraction(b, a, 1-x)/b
+	}
+	return front * betaContinuedFraction(a, b, x) / a
+}
+
+// betaContinuedFraction evaluates the continued fraction of the incomplete beta
+// function with the modified Lentz method
+func betaContinuedFraction(a, b, x float64) float64 {
+	const (
+		maxIterations = 200
+		epsilon       = 1e-12
+		tiny          = 1e-300
+	)
+
+	c, d := 1.0, 1-(a+b)*x/(a+1)
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+	for m := 1; m <= maxIterations; m++ {
+		fm := float64(m)
+		for _, num := range []float64{
+			fm * (b - fm) * x / ((a + 2*fm - 1) * (a + 2*fm)),
+			-(a + fm) * (a + b + fm) * x / ((a + 2*fm) * (a + 2*fm + 1)),
+		} {
+			d = 1 + num*d
+			if math.Abs(d) < tiny {
+				d = tiny
+			}
+			c = 1 + num/c
+			if math.Abs(c) < tiny {
+				c = tiny
+			}
+			d = 1 / d
+			h *= d * c
+		}
+		if math.Abs(d*c-1) < epsilon {
+			break
+		}
+	}
+	return h
+}
+
+func mean(xs []float64) float64 {
+	if len(xs) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, x := range xs {
+		sum += x
+	}
+	return sum / float64(len(xs))
+}
+
+// variance returns the sample variance
+func variance(xs []float64) float64 {
+	if len(xs) < 2 {
+		return 0
+	}
+	m := mean(xs)
+	sum := 0.0
+	for _, x := range xs {
+		sum += (x - m) * (x - m)
+	}
+	return sum / float64(len(xs)-1)
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+const (
+	mentionsCursorKey = "mentions:" // Search cursor key for the mentions timeline
+	mentionsPerCheck  = 20
+	// Mentions older than this are not followed up, so a first check does not
+	// answer stale conversations
+	followUpLookback = 24 * time.Hour
+	// Walking up a conversation stops after this many tweets
+	maxFollowUpChain = 50
+)
+
+// followUpInstructions tells the LLM the reply continues a conversation
+func followUpInstructions(username string) string {
+	you := "you"
+	if username != "" {
+		you = "you (@" + strings.TrimPrefix(username, "@") + ")"
+	}
+	return fmt.Sprintf("This tweet replies to an earlier reply by %s in the thread above. "+
+		"Answer them directly and move the conversation forward; do not repeat what you already said.", you)
+}
+
+// ProcessMentions checks the account's new mentions for replies to its posted
+// replies and queues a follow-up for each, down to the account's depth limit.
+// Follow-ups always go through the approval queue.
+func (s *ReplyService) ProcessMentions(ctx context.Context, accountID string) error {
+	cfg, err := s.accountSvc.GetAccount(accountID)
+	if err != nil {
+		return err
+	}
+	if !cfg.FollowUps.Enabled {
+		return nil
+	}
+
+	client, err := s.accountSvc.GetClient(accountID)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := s.metricsStore.GetSearchCursor(accountID, mentionsCursorKey)
+	if err != nil {
+		return err
+	}
+
+	mentions, err := client.GetMentions(ctx, ports.MentionsOptions{MaxResults: mentionsPerCheck, SinceID: cursor})
+	if err != nil {
+		return err
+	}
+
+	// Oldest first, so follow-ups queue in conversation order
+	queued := 0
+	for i := len(mentions) - 1; i >= 0; i-- {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if s.followUp(ctx, cfg, mentions[i]) {
+			queued++
+		}
+	}
+
+	if newest := newestTweetID(mentions); newest != "" && isNewerID(newest, cursor) {
+		if err := s.metricsStore.SaveSearchCursor(accountID, mentionsCursorKey, newest); err != nil {
+			return err
+		}
+	}
+
+	if queued > 0 {
+		s.log(accountID, domain.ActivityLevelSuccess, fmt.Sprintf("Queued %d follow-up%s for approval", queued, plural(queued)), "")
+	}
+	return nil
+}
+
+// followUp queues a follow-up to a mention if it replies to one of the
+// account's posted replies. Returns true if one was queued.
+func (s *ReplyService) followUp(ctx context.Context, cfg *domain.AccountConfig, mention domain.Tweet) bool {
+	if !mention.CreatedAt.IsZero() && mention.CreatedAt.Before(time.Now().Add(-followUpLookback)) {
+		return false
+	}
+	if strings.EqualFold(mention.AuthorUsername, strings.TrimPrefix(cfg.Username, "@")) {
+		return false
+	}
+	// The API says which tweet a mention replies to; other mentions are skipped without loading them
+	if mention.InReplyToID != "" && s.postedReply(cfg.ID, mention.InReplyToID) == nil {
+		return false
+	}
+	if replied, _ := s.metricsStore.IsReplied(cfg.ID, mention.ID); replied {
+		return false
+	}
+
+	tweet, thread, err := s.searchSvc.GetTweetDetails(ctx, cfg.ID, mention.ID)
+	if err != nil {
+		s.log(cfg.ID, domain.ActivityLevelWarning, "Failed to load mention", fmt.Sprintf("%s: %v", mention.ID, err))
+		return false
+	}
+	ours := s.postedReply(cfg.ID, tweet.InReplyToID)
+	if ours == nil {
+		return false
+	}
+
+	depth := s.followUpDepth(cfg.ID, *tweet, thread)
+	if depth > cfg.FollowUps.MaxDepth {
+		s.log(cfg.ID, domain.ActivityLevelInfo, "Follow-up depth limit reached", fmt.Sprintf("@%s: %s", tweet.AuthorUsername, truncate(tweet.Text, 50)))
+		return false
+	}
+
+	s.log(cfg.ID, domain.ActivityLevelInfo, "Reply to our reply", fmt.Sprintf("@%s: %s", tweet.AuthorUsername, truncate(tweet.Text, 50)))
+
+	tweet.AccountID = cfg.ID
+	tweet.DiscoveredAt = time.Now()
+	reply, err := s.generateReply(ctx, cfg.ID, *tweet, thread, depth)
+	if err != nil {
+		return false
+	}
+	reply.Violations = s.CheckGuardrails(ctx, cfg, *reply, *tweet)
+
+	if err := s.QueueReply(*reply, *tweet); err != nil {
+		s.log(cfg.ID, domain.ActivityLevelError, "Failed to queue follow-up", err.Error())
+		return false
+	}
+	return true
+}
+
+// postedReply returns the account's posted reply with the given tweet ID, or nil
+func (s *ReplyService) postedReply(accountID, tweetID string) *domain.Reply {
+	if tweetID == "" {
+		return nil
+	}
+	reply, err := s.replyStore.GetReplyByPostedID(tweetID)
+	if err != nil || reply.AccountID != accountID || reply.Status != domain.ReplyStatusPosted {
+		return nil
+	}
+	return reply
+}
+
+// followUpDepth counts the account's posted replies above a tweet in its
+// conversation, which is the depth a follow-up to it would have
+func (s *ReplyService) followUpDepth(accountID string, tweet domain.Tweet, thread []domain.Tweet) int {
+	byID := make(map[string]domain.Tweet, len(thread))
+	for _, t := range thread {
+		byID[t.ID] = t
+	}
+
+	depth := 0
+	id := tweet.InReplyToID
+	for i := 0; id != "" && i < maxFollowUpChain; i++ {
+		if s.postedReply(accountID, id) != nil {
+			depth++
+		}
+		parent, ok := byID[id]
+		if !ok {
+			break
+		}
+		id = parent.InReplyToID
+	}
+	return depth
+}
+
+func plural(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
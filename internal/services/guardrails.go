@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+// How many of the account's latest replies a reply is compared with for duplicates
+const guardrailRecentReplies = 50
+
+var (
+	guardrailLinkRe    = regexp.MustCompile(`(?i)\bhttps?://\S+|\bwww\.\S+|\b[a-z0-9-]+\.(?:com|net|org|io|co|xyz|ai|app|dev|gg|me|ly)\b`)
+	guardrailMentionRe = regexp.MustCompile(`(?:^|[^\w@])@(\w{1,15})`)
+	guardrailHashtagRe = regexp.MustCompile(`(?:^|\s)#\w+`)
+	guardrailWordRe    = regexp.MustCompile(`[\p{L}\p{N}']+`)
+)
+
+// CheckGuardrails runs the account's content checks on a reply before it is
+// posted and returns the violations found. Nothing is checked when guardrails
+// are off. An LLM moderation failure counts as a violation so unchecked
+// replies are reviewed.
+func (s *ReplyService) CheckGuardrails(ctx context.Context, cfg *domain.AccountConfig, reply domain.Reply, tweet domain.Tweet) []string {
+	g := cfg.Guardrails
+	if !g.Enabled {
+		return nil
+	}
+
+	violations := checkReplyContent(g, reply.Text, reply.TweetAuthor)
+
+	if g.DuplicateThreshold > 0 {
+		if v := s.checkDuplicate(reply, g.DuplicateThreshold); v != "" {
+			violations = append(violations, v)
+		}
+	}
+
+	if g.LLMModeration {
+		if v := s.moderate(ctx, cfg, reply, tweet); v != "" {
+			violations = append(violations, v)
+		}
+	}
+
+	if len(violations) > 0 {
+		s.log(reply.AccountID, domain.ActivityLevelWarning, "Reply failed guardrails", strings.Join(violations, "; "))
+	}
+	return violations
+}
+
+// checkReplyContent runs the checks that need nothing but the text. author is
+// the username being replied to, whom the reply may mention.
+func checkReplyContent(g domain.GuardrailConfig, text, author string) []string {
+	var violations []string
+
+	for _, word := range g.BannedWords {
+		word = strings.TrimSpace(word)
+		if word == "" {
+			continue
+		}
+		re := regexp.MustCompile(`(?i)(?:^|\W)` + regexp.QuoteMeta(word) + `(?:\W|$)`)
+		if re.MatchString(text) {
+			violations = append(violations, fmt.Sprintf("Contains banned word %q", word))
+		}
+	}
+
+	for _, pattern := range g.BannedPatterns {
+		if strings.TrimSpace(pattern) == "" {
+			continue
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			violations = append(violations, fmt.Sprintf("Invalid banned pattern %q", pattern))
+			continue
+		}
+		if re.MatchString(text) {
+			violations = append(violations, fmt.Sprintf("Matches banned pattern %q", pattern))
+		}
+	}
+
+	if !g.AllowLinks && guardrailLinkRe.MatchString(text) {
+		violations = append(violations, "Contains a link")
+	}
+
+	if !g.AllowMentions {
+		for _, m := range guardrailMentionRe.FindAllStringSubmatch(text, -1) {
+			if !strings.EqualFold(m[1], strings.TrimPrefix(author, "@")) {
+				violations = append(violations, fmt.Sprintf("Mentions @%s", m[1]))
+				break
+			}
+		}
+	}
+
+	if g.MaxHashtags > 0 {
+		if n := len(guardrailHashtagRe.FindAllString(text, -1)); n > g.MaxHashtags {
+			violations = append(violations, fmt.Sprintf("Has %d hashtags (max %d)", n, g.MaxHashtags))
+		}
+	}
+
+	return violations
+}
+
+// checkDuplicate compares a reply with the account's recent and queued replies
+func (s *ReplyService) checkDuplicate(reply domain.Reply, threshold float64) string {
+	var recent []string
+	if replies, err := s.replyStore.GetReplies(reply.AccountID, guardrailRecentReplies); err == nil {
+		for _, r := range replies {
+			if r.ID != reply.ID && r.Status != domain.ReplyStatusRejected {
+				recent = append(recent, r.Text)
+			}
+		}
+	}
+	if pending, err := s.replyStore.GetPendingReplies(reply.AccountID); err == nil {
+		for _, p := range pending {
+			if p.Reply.ID != reply.ID {
+				recent = append(recent, p.Reply.Text)
+			}
+		}
+	}
+
+	words := wordSet(reply.Text)
+	best := 0.0
+	for _, text := range recent {
+		best = max(best, jaccard(words, wordSet(text)))
+	}
+	if best >= threshold {
+		return fmt.Sprintf("Near-duplicate of a recent reply (%.0f%% similar)", best*100)
+	}
+	return ""
+}
+
+// wordSet returns the lowercased words of a text
+func wordSet(text string) map[string]bool {
+	set := make(map[string]bool)
+	for _, w := range guardrailWordRe.FindAllString(strings.ToLower(text), -1) {
+		set[w] = true
+	}
+	return set
+}
+
+// jaccard returns the share of words two sets have in common
+func jaccard(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for w := range a {
+		if b[w] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+// moderate asks the account's LLM whether the reply is safe to post
+func (s *ReplyService) moderate(ctx context.Context, cfg *domain.AccountConfig, reply domain.Reply, tweet domain.Tweet) string {
+	llm, err := s.llmFactory.CreateProvider(cfg.LLMConfig)
+	if err != nil {
+		return fmt.Sprintf("LLM moderation unavailable: %v", err)
+	}
+	defer llm.Close()
+
+	resp, err := llm.ModerateReply(ctx, ports.ModerationRequest{Text: reply.Text, OriginalTweet: tweet})
+	if err != nil {
+		return fmt.Sprintf("LLM moderation failed: %v", err)
+	}
+	if resp.Flagged {
+		if resp.Reason == "" {
+			return "Flagged by LLM moderation"
+		}
+		return "Flagged by LLM moderation: " + resp.Reason
+	}
+	return ""
+}
@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"net/url"
 	"sync"
 	"time"
 
@@ -15,20 +17,56 @@ import (
 const (
 	NotifyTypeBigTrade    = "big_trade"
 	NotifyTypeFreshWallet = "fresh_wallet"
+	NotifyTypeDigest      = "digest"
+)
+
+// Digest scheduling and contents
+const (
+	digestCheckInterval = 5 * time.Minute
+	digestTopTrades     = 10
+	digestFreshWallets  = 25
+	digestTopReplies    = 5
+)
+
+// Twitter automation alert tuning
+const (
+	approvalBatchWindow          = 2 * time.Minute // Collect queued replies into one alert
+	accountErrorCooldown         = time.Hour       // Minimum gap between alerts for the same account problem
+	defaultReplyFailureThreshold = 3
 )
 
 // NotificationService handles notification orchestration
 type NotificationService struct {
-	mu       sync.RWMutex
-	config   domain.NotificationConfig
-	store    ports.NotificationStore
-	eventBus ports.EventBus
-	telegram *notification.TelegramNotifier
-	stopCh   chan struct{}
+	mu           sync.RWMutex
+	config       domain.NotificationConfig
+	store        ports.NotificationStore
+	replyStore   ports.ReplyStore
+	metricsStore ports.MetricsStore
+	configStore  ports.ConfigStore
+	eventBus     ports.EventBus
+	telegram     *notification.TelegramNotifier
+	email        *notification.EmailNotifier
+	discord      *notification.WebhookNotifier
+	slack        *notification.WebhookNotifier
+	webhook      *notification.WebhookNotifier
+	senders      map[domain.NotificationChannel]ports.NotificationSender
+	renderer     *notification.TemplateRenderer
+	stopCh       chan struct{}
+
+	// Twitter automation alert state
+	approvalTimer     *time.Timer
+	replyFailures     map[string]int       // accountID -> consecutive failures
+	accountErrorsSent map[string]time.Time // accountID:status -> last alert
 }
 
 // NewNotificationService creates a new notification service
-func NewNotificationService(store ports.NotificationStore, eventBus ports.EventBus) *NotificationService {
+func NewNotificationService(
+	store ports.NotificationStore,
+	replyStore ports.ReplyStore,
+	metricsStore ports.MetricsStore,
+	configStore ports.ConfigStore,
+	eventBus ports.EventBus,
+) *NotificationService {
 	// Load config from database
 	config, err := store.LoadNotificationConfig()
 	if err != nil {
@@ -40,10 +78,30 @@ func NewNotificationService(store ports.NotificationStore, eventBus ports.EventB
 	}
 
 	svc := &NotificationService{
-		config:   config,
-		store:    store,
-		eventBus: eventBus,
-		telegram: notification.NewTelegramNotifier(config.TelegramBotToken, config.TelegramChatIDs),
+		config:       config,
+		store:        store,
+		replyStore:   replyStore,
+		metricsStore: metricsStore,
+		configStore:  configStore,
+		eventBus:     eventBus,
+		telegram:     notification.NewTelegramNotifier(config.TelegramBotToken, config.TelegramChatIDs),
+		email:        notification.NewEmailNotifier(config.SMTP),
+		discord:      notification.NewWebhookNotifier(domain.NotificationChannelDiscord, config.DiscordWebhookURL),
+		slack:        notification.NewWebhookNotifier(domain.NotificationChannelSlack, config.SlackWebhookURL),
+		webhook:      notification.NewWebhookNotifier(domain.NotificationChannelWebhook, config.WebhookURL),
+		renderer:     notification.NewTemplateRenderer(config.Templates),
+
+		replyFailures:     make(map[string]int),
+		accountErrorsSent: make(map[string]time.Time),
+	}
+
+	svc.senders = map[domain.NotificationChannel]ports.NotificationSender{
+		domain.NotificationChannelTelegram: svc.telegram,
+		domain.NotificationChannelDesktop:  notification.NewDesktopNotifier(eventBus),
+		domain.NotificationChannelEmail:    svc.email,
+		domain.NotificationChannelDiscord:  svc.discord,
+		domain.NotificationChannelSlack:    svc.slack,
+		domain.NotificationChannelWebhook:  svc.webhook,
 	}
 
 	return svc
@@ -57,6 +115,7 @@ func (s *NotificationService) Start() {
 		return // Already running
 	}
 	s.stopCh = make(chan struct{})
+	stopCh := s.stopCh
 	s.mu.Unlock()
 
 	log.Println("[NotificationService] Starting notification service")
@@ -64,6 +123,14 @@ func (s *NotificationService) Start() {
 	// Subscribe to polymarket events
 	s.eventBus.Subscribe("polymarket:event", s.handlePolymarketEvent)
 	s.eventBus.Subscribe("polymarket:fresh_wallet_detected", s.handleFreshWalletDetected)
+
+	// Subscribe to Twitter automation events
+	s.eventBus.Subscribe(ports.EventApprovalRequired, s.handleApprovalRequired)
+	s.eventBus.Subscribe(ports.EventReplyPosted, s.handleReplyPosted)
+	s.eventBus.Subscribe(ports.EventReplyFailed, s.handleReplyFailed)
+	s.eventBus.Subscribe(ports.EventAccountError, s.handleAccountError)
+
+	go s.runDigestLoop(stopCh)
 }
 
 // Stop stops the notification service
@@ -75,6 +142,10 @@ func (s *NotificationService) Stop() {
 		close(s.stopCh)
 		s.stopCh = nil
 	}
+	if s.approvalTimer != nil {
+		s.approvalTimer.Stop()
+		s.approvalTimer = nil
+	}
 
 	log.Println("[NotificationService] Stopped notification service")
 }
@@ -88,11 +159,43 @@ func (s *NotificationService) GetConfig() domain.NotificationConfig {
 
 // UpdateConfig updates the notification configuration
 func (s *NotificationService) UpdateConfig(config domain.NotificationConfig) error {
+	if config.DigestHour < 0 || config.DigestHour > 23 {
+		return fmt.Errorf("digest hour must be between 0 and 23")
+	}
+
+	for channel, rawURL := range map[domain.NotificationChannel]string{
+		domain.NotificationChannelDiscord: config.DiscordWebhookURL,
+		domain.NotificationChannelSlack:   config.SlackWebhookURL,
+		domain.NotificationChannelWebhook: config.WebhookURL,
+	} {
+		if rawURL == "" {
+			continue
+		}
+		if u, err := url.Parse(rawURL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return fmt.Errorf("invalid %s webhook URL", channel)
+		}
+	}
+
+	// Reject templates that don't parse or render before touching anything
+	for _, t := range config.Templates {
+		if t.Body == "" {
+			continue
+		}
+		if err := notification.ValidateTemplate(t); err != nil {
+			return fmt.Errorf("invalid %s template for %s: %w", t.EventType, t.Channel, err)
+		}
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	s.config = config
 	s.telegram.UpdateConfig(config.TelegramBotToken, config.TelegramChatIDs)
+	s.email.UpdateConfig(config.SMTP)
+	s.discord.UpdateConfig(config.DiscordWebhookURL)
+	s.slack.UpdateConfig(config.SlackWebhookURL)
+	s.webhook.UpdateConfig(config.WebhookURL)
+	s.renderer.SetTemplates(config.Templates)
 
 	// Save to database
 	if err := s.store.SaveNotificationConfig(config); err != nil {
@@ -117,10 +220,170 @@ func (s *NotificationService) SendTestNotification(ctx context.Context) error {
 	}
 
 	if !config.IsConfigured() {
-		return &notification.NotificationError{Message: "Telegram is not configured. Please provide bot token and chat ID."}
+		return &notification.NotificationError{Message: "No notification channel is configured. Please set up Telegram, email, webhook or desktop notifications."}
+	}
+
+	// Send to every routed channel, reporting the first failure
+	var firstErr error
+	for _, channel := range config.ActiveChannels() {
+		sender, ok := s.senders[channel]
+		if !ok || !config.IsChannelConfigured(channel) {
+			continue
+		}
+		if err := sender.SendTest(ctx); err != nil {
+			log.Printf("[NotificationService] Test notification failed on %s: %v", channel, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// SendDigest builds and emails the daily digest for the last 24 hours
+func (s *NotificationService) SendDigest(ctx context.Context) error {
+	s.mu.RLock()
+	config := s.config
+	s.mu.RUnlock()
+
+	if !config.SMTP.IsConfigured() {
+		return &notification.NotificationError{Message: "Email is not configured. Digests are sent by email."}
+	}
+
+	until := time.Now()
+	digest, err := s.buildDigest(until.Add(-24*time.Hour), until)
+	if err != nil {
+		return err
+	}
+
+	subject, body, err := notification.RenderDigestEmail(digest)
+	if err != nil {
+		return err
+	}
+
+	return s.email.SendEmail(ctx, subject, body)
+}
+
+// buildDigest collects trades, fresh wallets, reply stats and top replies for a period
+func (s *NotificationService) buildDigest(since, until time.Time) (domain.NotificationDigest, error) {
+	digest := domain.NotificationDigest{Since: since, Until: until}
+
+	trades, err := s.store.GetEvents(domain.PolymarketEventFilter{
+		EventTypes:  []domain.PolymarketEventType{domain.PolymarketEventTrade},
+		Since:       since,
+		SortByValue: true,
+		Limit:       digestTopTrades,
+	})
+	if err != nil {
+		return digest, fmt.Errorf("failed to load top trades: %w", err)
+	}
+	digest.TopTrades = trades
+
+	wallets, err := s.store.GetFreshWalletsSince(since, digestFreshWallets)
+	if err != nil {
+		return digest, fmt.Errorf("failed to load fresh wallets: %w", err)
+	}
+	digest.FreshWallets = wallets
+
+	if s.replyStore != nil {
+		stats, err := s.replyStore.GetReplyStats(since)
+		if err != nil {
+			return digest, fmt.Errorf("failed to load reply stats: %w", err)
+		}
+
+		// Show usernames instead of account IDs where known
+		usernames := make(map[string]string)
+		if s.configStore != nil {
+			if accounts, err := s.configStore.ListAccounts(); err == nil {
+				for _, acc := range accounts {
+					usernames[acc.ID] = acc.Username
+				}
+			}
+		}
+		for i := range stats {
+			stats[i].Username = usernames[stats[i].AccountID]
+		}
+		digest.ReplyStats = stats
+
+		if s.metricsStore != nil {
+			top, err := s.metricsStore.GetTopReplies("", since, digestTopReplies)
+			if err != nil {
+				return digest, fmt.Errorf("failed to load top replies: %w", err)
+			}
+			fillReplyDetails(s.replyStore, top)
+			digest.TopReplies = top
+		}
+	}
+
+	return digest, nil
+}
+
+// runDigestLoop sends the daily digest once per day at the configured hour
+func (s *NotificationService) runDigestLoop(stopCh chan struct{}) {
+	ticker := time.NewTicker(digestCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			s.maybeSendDigest()
+		}
+	}
+}
+
+// maybeSendDigest sends today's digest if it is due and hasn't been sent yet
+func (s *NotificationService) maybeSendDigest() {
+	s.mu.RLock()
+	config := s.config
+	s.mu.RUnlock()
+
+	if !config.Enabled || !config.DigestEnabled || !config.SMTP.IsConfigured() {
+		return
+	}
+
+	now := time.Now()
+	if now.Hour() < config.DigestHour {
+		return
+	}
+
+	today := now.Format("2006-01-02")
+	sent, err := s.store.HasNotified(NotifyTypeDigest, today)
+	if err != nil {
+		log.Printf("[NotificationService] Error checking digest status: %v", err)
+		return
+	}
+	if sent {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := s.SendDigest(ctx); err != nil {
+		log.Printf("[NotificationService] Failed to send daily digest: %v", err)
+		return
+	}
+
+	if err := s.store.MarkNotified(NotifyTypeDigest, today); err != nil {
+		log.Printf("[NotificationService] Error marking digest as sent: %v", err)
 	}
+	log.Printf("[NotificationService] Daily digest sent for %s", today)
+}
 
-	return s.telegram.SendTest(ctx)
+// PreviewTemplate renders a template against sample data
+func (s *NotificationService) PreviewTemplate(tmpl domain.NotificationTemplate) (string, error) {
+	if tmpl.Body == "" {
+		tmpl.Body = notification.DefaultTemplate(tmpl.EventType, tmpl.Channel)
+	}
+	return notification.PreviewTemplate(tmpl)
+}
+
+// GetDefaultTemplates returns the built-in templates for every event type and channel
+func (s *NotificationService) GetDefaultTemplates() []domain.NotificationTemplate {
+	return notification.DefaultTemplates()
 }
 
 // handlePolymarketEvent handles incoming Polymarket trade events
@@ -164,7 +427,7 @@ func (s *NotificationService) handlePolymarketEvent(data interface{}) {
 
 	// Send big trade notification
 	content := domain.NewBigTradeNotification(event)
-	s.sendNotificationAsync(content)
+	s.sendNotificationAsync(content, notification.NewBigTradeTemplateData(event))
 }
 
 // handleFreshWalletDetected handles fresh wallet detection events
@@ -201,19 +464,180 @@ func (s *NotificationService) handleFreshWalletDetected(data interface{}) {
 
 	// Send fresh wallet notification
 	content := domain.NewFreshWalletNotification(profile)
-	s.sendNotificationAsync(content)
+	s.sendNotificationAsync(content, notification.NewFreshWalletTemplateData(profile))
+}
+
+// handleApprovalRequired batches queued replies into a single alert
+func (s *NotificationService) handleApprovalRequired(data interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.config.Enabled || !s.config.NotifyApprovalQueue {
+		return
+	}
+
+	// Start a batch window unless one is already collecting
+	if s.approvalTimer == nil {
+		s.approvalTimer = time.AfterFunc(approvalBatchWindow, s.flushApprovalAlert)
+	}
+}
+
+// flushApprovalAlert sends the pending reply counts per account
+func (s *NotificationService) flushApprovalAlert() {
+	s.mu.Lock()
+	s.approvalTimer = nil
+	s.mu.Unlock()
+
+	if s.replyStore == nil {
+		return
+	}
+
+	items, err := s.replyStore.GetPendingReplies("")
+	if err != nil {
+		log.Printf("[NotificationService] Failed to load pending replies: %v", err)
+		return
+	}
+	if len(items) == 0 {
+		return // Queue was cleared during the batch window
+	}
+
+	counts := make(map[string]int)
+	var order []string
+	for _, item := range items {
+		accountID := item.Reply.AccountID
+		if counts[accountID] == 0 {
+			order = append(order, accountID)
+		}
+		counts[accountID]++
+	}
+
+	pending := make([]notification.AccountPendingCount, 0, len(order))
+	for _, accountID := range order {
+		pending = append(pending, notification.AccountPendingCount{
+			AccountID: accountID,
+			Username:  s.usernameFor(accountID),
+			Count:     counts[accountID],
+		})
+	}
+
+	content := domain.NewApprovalRequiredNotification(len(items))
+	s.sendNotificationAsync(content, notification.NewApprovalRequiredTemplateData(pending))
+}
+
+// handleReplyPosted resets the failure streak for an account
+func (s *NotificationService) handleReplyPosted(data interface{}) {
+	event, ok := data.(ports.ReplyEvent)
+	if !ok || event.AccountID == "" {
+		return
+	}
+
+	s.mu.Lock()
+	delete(s.replyFailures, event.AccountID)
+	s.mu.Unlock()
 }
 
-// sendNotificationAsync sends a notification asynchronously
-func (s *NotificationService) sendNotificationAsync(content domain.NotificationContent) {
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
+// handleReplyFailed alerts once an account reaches the consecutive failure threshold
+func (s *NotificationService) handleReplyFailed(data interface{}) {
+	event, ok := data.(ports.ReplyEvent)
+	if !ok || event.AccountID == "" {
+		return
+	}
+
+	s.mu.Lock()
+	config := s.config
+	threshold := config.ReplyFailureThreshold
+	if threshold <= 0 {
+		threshold = defaultReplyFailureThreshold
+	}
+	s.replyFailures[event.AccountID]++
+	failures := s.replyFailures[event.AccountID]
+	if failures >= threshold {
+		// Start a new streak so we alert again only after another run of failures
+		delete(s.replyFailures, event.AccountID)
+	}
+	s.mu.Unlock()
+
+	if failures < threshold || !config.Enabled || !config.NotifyReplyFailures {
+		return
+	}
 
-		if err := s.telegram.Send(ctx, content); err != nil {
-			log.Printf("[NotificationService] Failed to send notification: %v", err)
+	content := domain.NewReplyFailedNotification(event.AccountID, failures, event.Error)
+	s.sendNotificationAsync(content, notification.NewReplyFailedTemplateData(
+		event.AccountID, s.usernameFor(event.AccountID), failures, event.Error))
+}
+
+// handleAccountError alerts on authentication failures such as expired cookies
+func (s *NotificationService) handleAccountError(data interface{}) {
+	event, ok := data.(ports.AccountStatusEvent)
+	if !ok || event.AccountID == "" {
+		return
+	}
+
+	s.mu.Lock()
+	config := s.config
+	if !config.Enabled || !config.NotifyAccountErrors {
+		s.mu.Unlock()
+		return
+	}
+
+	// Workers retry on every cycle, so avoid repeating the same alert
+	key := event.AccountID + ":" + event.Status
+	if last, ok := s.accountErrorsSent[key]; ok && time.Since(last) < accountErrorCooldown {
+		s.mu.Unlock()
+		return
+	}
+	s.accountErrorsSent[key] = time.Now()
+	s.mu.Unlock()
+
+	content := domain.NewAccountErrorNotification(event.AccountID, event.Status, event.Error)
+	s.sendNotificationAsync(content, notification.NewAccountErrorTemplateData(
+		event.AccountID, s.usernameFor(event.AccountID), event.Status, event.Error))
+}
+
+// usernameFor returns the configured username for an account, if any
+func (s *NotificationService) usernameFor(accountID string) string {
+	if s.configStore == nil {
+		return ""
+	}
+	cfg, err := s.configStore.LoadAccount(accountID)
+	if err != nil {
+		return ""
+	}
+	return cfg.Username
+}
+
+// sendNotificationAsync renders and sends a notification to each routed channel asynchronously
+func (s *NotificationService) sendNotificationAsync(content domain.NotificationContent, data notification.TemplateData) {
+	s.mu.RLock()
+	config := s.config
+	s.mu.RUnlock()
+
+	for _, channel := range config.ChannelsFor(content.EventType) {
+		sender, ok := s.senders[channel]
+		if !ok {
+			log.Printf("[NotificationService] No sender for channel %s, skipping", channel)
+			continue
+		}
+		if !config.IsChannelConfigured(channel) {
+			continue
 		}
-	}()
+
+		go func(channel domain.NotificationChannel, sender ports.NotificationSender, content domain.NotificationContent) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			message, err := s.renderer.Render(channel, data)
+			if err != nil {
+				log.Printf("[NotificationService] Failed to render %s template for %s: %v", content.EventType, channel, err)
+				return
+			}
+			content.Message = message
+
+			if err := sender.Send(ctx, content); err != nil {
+				log.Printf("[NotificationService] Failed to send notification via %s: %v", channel, err)
+			}
+		}(channel, sender, content)
+	}
 }
 
 // IsConfigured returns true if notifications are configured and enabled
@@ -0,0 +1,205 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"sync"
+	"time"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+// How often the posting queue is checked for due replies
+const postingQueueInterval = 15 * time.Second
+
+// defaultReplyGap separates replies when the account sets no minimum delay
+const defaultReplyGap = 60 * time.Second
+
+// ScheduleReply puts an approved reply in the posting queue at the account's
+// next free slot, honoring its minimum delay, daily spread, jitter and active hours
+func (s *ReplyService) ScheduleReply(reply domain.Reply) error {
+	cfg, err := s.accountSvc.GetAccount(reply.AccountID)
+	if err != nil {
+		return err
+	}
+
+	s.scheduleMu.Lock()
+	defer s.scheduleMu.Unlock()
+
+	last, err := s.replyStore.LastReplySlot(reply.AccountID)
+	if err != nil {
+		return err
+	}
+
+	at := nextPostingSlot(cfg, last, time.Now())
+	reply.Status = domain.ReplyStatusScheduled
+	reply.ScheduledFor = &at
+	if err := s.replyStore.SaveReply(reply); err != nil {
+		return err
+	}
+
+	s.log(reply.AccountID, domain.ActivityLevelInfo, "Reply scheduled", fmt.Sprintf("Posting at %s", at.Format("Jan 2 15:04")))
+	s.eventBus.Emit(ports.EventReplyScheduled, ports.ReplyEvent{
+		AccountID: reply.AccountID,
+		Reply:     &reply,
+		TweetID:   reply.TweetID,
+	})
+
+	return nil
+}
+
+// nextPostingSlot returns when the next reply may post given the account's last
+// posted or scheduled reply
+func nextPostingSlot(cfg *domain.AccountConfig, last, now time.Time) time.Time {
+	gap := defaultReplyGap
+	if cfg.RateLimits.MinDelayBetween > 0 {
+		gap = time.Duration(cfg.RateLimits.MinDelayBetween) * time.Second
+	}
+	if cfg.Posting.SpreadDaily && cfg.RateLimits.RepliesPerDay > 0 {
+		gap = max(gap, cfg.Posting.ActiveWindow()/time.Duration(cfg.RateLimits.RepliesPerDay))
+	}
+
+	at := now
+	if !last.IsZero() && last.Add(gap).After(at) {
+		at = last.Add(gap)
+	}
+
+	// Jitter first, so it cannot push the slot out of active hours
+	if cfg.Posting.JitterSecs > 0 {
+		at = at.Add(time.Duration(rand.Int63n(int64(cfg.Posting.JitterSecs)+1)) * time.Second)
+	}
+	return cfg.Posting.NextActive(at)
+}
+
+// PostReplyNow moves a scheduled reply to the front of the posting queue
+func (s *ReplyService) PostReplyNow(replyID string) error {
+	reply, err := s.replyStore.GetReplyByID(replyID)
+	if err != nil {
+		return err
+	}
+	if reply.Status != domain.ReplyStatusScheduled {
+		return fmt.Errorf("reply is not scheduled")
+	}
+
+	now := time.Now()
+	reply.ScheduledFor = &now
+	return s.replyStore.SaveReply(*reply)
+}
+
+// PostingQueueService posts scheduled replies when their slot comes up. Each
+// account posts on its own goroutine, so a rate-limit wait only holds up that account.
+type PostingQueueService struct {
+	replySvc   *ReplyService
+	replyStore ports.ReplyStore
+
+	mu      sync.Mutex
+	stopCh  chan struct{}
+	posting map[string]bool // Accounts with a posting goroutine running
+}
+
+// NewPostingQueueService creates a new posting queue service
+func NewPostingQueueService(replySvc *ReplyService, replyStore ports.ReplyStore) *PostingQueueService {
+	return &PostingQueueService{
+		replySvc:   replySvc,
+		replyStore: replyStore,
+		posting:    make(map[string]bool),
+	}
+}
+
+// Start begins posting due replies in the background
+func (s *PostingQueueService) Start() {
+	s.mu.Lock()
+	if s.stopCh != nil {
+		s.mu.Unlock()
+		return // Already running
+	}
+	s.stopCh = make(chan struct{})
+	stopCh := s.stopCh
+	s.mu.Unlock()
+
+	// Replies left mid-post by a previous run may already be live
+	if n, err := s.replyStore.FailInterruptedReplies(); err != nil {
+		log.Printf("[PostingQueueService] Failed to check interrupted replies: %v", err)
+	} else if n > 0 {
+		log.Printf("[PostingQueueService] Marked %d interrupted reply(s) as failed", n)
+	}
+
+	go s.run(stopCh)
+}
+
+// Stop stops the background poster
+func (s *PostingQueueService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopCh != nil {
+		close(s.stopCh)
+		s.stopCh = nil
+	}
+}
+
+func (s *PostingQueueService) run(stopCh chan struct{}) {
+	ticker := time.NewTicker(postingQueueInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			s.postDue()
+		}
+	}
+}
+
+// postDue starts posting the due replies of every account that is not
+// already posting
+func (s *PostingQueueService) postDue() {
+	replies, err := s.replyStore.GetDueReplies(time.Now())
+	if err != nil {
+		log.Printf("[PostingQueueService] Failed to get due replies: %v", err)
+		return
+	}
+
+	byAccount := make(map[string][]domain.Reply)
+	var accounts []string
+	for _, reply := range replies {
+		if _, ok := byAccount[reply.AccountID]; !ok {
+			accounts = append(accounts, reply.AccountID)
+		}
+		byAccount[reply.AccountID] = append(byAccount[reply.AccountID], reply)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, accountID := range accounts {
+		if s.posting[accountID] {
+			continue // Picked up on a later tick
+		}
+		s.posting[accountID] = true
+		go s.postAccount(accountID, byAccount[accountID])
+	}
+}
+
+// postAccount posts one account's due replies in order
+func (s *PostingQueueService) postAccount(accountID string, replies []domain.Reply) {
+	defer func() {
+		s.mu.Lock()
+		delete(s.posting, accountID)
+		s.mu.Unlock()
+	}()
+
+	for _, reply := range replies {
+		claimed, err := s.replyStore.ClaimScheduledReply(reply.ID)
+		if err != nil || !claimed {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		s.replySvc.PostReply(ctx, reply)
+		cancel()
+	}
+}
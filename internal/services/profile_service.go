@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+// How often follower metrics are recorded for each enabled account
+const (
+	profileSnapshotInterval = 6 * time.Hour
+	profileSnapshotDelay    = time.Minute // Let workers start up first
+)
+
+// ProfileService records periodic profile snapshots for follower growth tracking
+type ProfileService struct {
+	accountSvc   *AccountService
+	metricsStore ports.MetricsStore
+
+	mu     sync.Mutex
+	stopCh chan struct{}
+}
+
+// NewProfileService creates a new profile service
+func NewProfileService(accountSvc *AccountService, metricsStore ports.MetricsStore) *ProfileService {
+	return &ProfileService{
+		accountSvc:   accountSvc,
+		metricsStore: metricsStore,
+	}
+}
+
+// Start begins recording snapshots in the background
+func (s *ProfileService) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopCh != nil {
+		return // Already running
+	}
+	s.stopCh = make(chan struct{})
+	go s.run(s.stopCh)
+}
+
+// Stop stops the background recorder
+func (s *ProfileService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopCh != nil {
+		close(s.stopCh)
+		s.stopCh = nil
+	}
+}
+
+func (s *ProfileService) run(stopCh chan struct{}) {
+	select {
+	case <-stopCh:
+		return
+	case <-time.After(profileSnapshotDelay):
+	}
+
+	ticker := time.NewTicker(profileSnapshotInterval)
+	defer ticker.Stop()
+
+	for {
+		s.recordAll()
+
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// recordAll records a snapshot for every enabled account that is due one
+func (s *ProfileService) recordAll() {
+	accounts, err := s.accountSvc.ListAccounts()
+	if err != nil {
+		log.Printf("[ProfileService] Failed to list accounts: %v", err)
+		return
+	}
+
+	for _, cfg := range accounts {
+		if !cfg.Enabled || !s.snapshotDue(cfg.ID) {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		if _, err := s.RecordSnapshot(ctx, cfg.ID); err != nil {
+			log.Printf("[ProfileService] Failed to record snapshot for %s: %v", cfg.ID, err)
+		}
+		cancel()
+	}
+}
+
+// snapshotDue returns true if the account's latest snapshot is older than the interval
+func (s *ProfileService) snapshotDue(accountID string) bool {
+	history, err := s.metricsStore.GetProfileHistory(accountID, 1)
+	if err != nil || len(history) == 0 {
+		return true
+	}
+	return time.Since(history[0].Timestamp) >= profileSnapshotInterval-time.Minute
+}
+
+// RecordSnapshot fetches the account's profile and stores its current counts
+func (s *ProfileService) RecordSnapshot(ctx context.Context, accountID string) (*domain.ProfileSnapshot, error) {
+	client, err := s.accountSvc.GetClient(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := client.GetProfile(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+
+	snapshot := domain.ProfileSnapshot{
+		AccountID:      accountID,
+		Timestamp:      time.Now(),
+		FollowersCount: profile.FollowersCount,
+		FollowingCount: profile.FollowingCount,
+		TweetCount:     profile.TweetCount,
+	}
+	if err := s.metricsStore.SaveProfileSnapshot(snapshot); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[ProfileService] @%s: %d followers, %d following", profile.Username, profile.FollowersCount, profile.FollowingCount)
+	return &snapshot, nil
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+// relevanceBatchSize is how many tweets are classified per LLM request
+const relevanceBatchSize = 10
+
+// FilterRelevant scores found tweets against the account's topic with the LLM and
+// drops those below the minimum relevance. Tweets are returned unchanged when the
+// filter is off; tweets the LLM failed to classify are kept unscored.
+func (s *ReplyService) FilterRelevant(ctx context.Context, accountID string, tweets []domain.Tweet) ([]domain.Tweet, error) {
+	cfg, err := s.accountSvc.GetAccount(accountID)
+	if err != nil {
+		return tweets, err
+	}
+
+	rel := cfg.Relevance
+	if !rel.Enabled || strings.TrimSpace(rel.Topic) == "" || len(tweets) == 0 {
+		return tweets, nil
+	}
+
+	// Classification can use a cheaper model on the same endpoint
+	llmConfig := cfg.LLMConfig
+	if rel.Model != "" {
+		llmConfig.Model = rel.Model
+	}
+	llm, err := s.llmFactory.CreateProvider(llmConfig)
+	if err != nil {
+		return tweets, fmt.Errorf("failed to create LLM provider: %w", err)
+	}
+	defer llm.Close()
+
+	classified := make(map[string]ports.TweetClassification, len(tweets))
+	tokensUsed := 0
+	for start := 0; start < len(tweets); start += relevanceBatchSize {
+		batch := tweets[start:min(start+relevanceBatchSize, len(tweets))]
+
+		resp, err := llm.ClassifyTweets(ctx, ports.ClassifyRequest{Topic: rel.Topic, Tweets: batch})
+		if err != nil {
+			s.log(accountID, domain.ActivityLevelWarning, "Relevance check failed, keeping tweets unscored", err.Error())
+			continue
+		}
+		tokensUsed += resp.TokensUsed
+		for _, c := range resp.Results {
+			classified[c.TweetID] = c
+		}
+	}
+
+	kept := make([]domain.Tweet, 0, len(tweets))
+	for _, tweet := range tweets {
+		c, ok := classified[tweet.ID]
+		if !ok {
+			kept = append(kept, tweet)
+			continue
+		}
+
+		relevance := c.Relevance
+		tweet.Relevance = &relevance
+		tweet.Sentiment = c.Sentiment
+		tweet.RelevanceReason = c.Reason
+
+		// Allowlisted authors are always kept
+		if relevance < rel.MinScore && !tweet.Allowlisted {
+			s.log(accountID, domain.ActivityLevelInfo, fmt.Sprintf("Skipped off-topic tweet from @%s (%.2f)", tweet.AuthorUsername, relevance), c.Reason)
+			continue
+		}
+		kept = append(kept, tweet)
+	}
+
+	s.log(accountID, domain.ActivityLevelInfo,
+		fmt.Sprintf("Relevance filter kept %d of %d tweets", len(kept), len(tweets)),
+		fmt.Sprintf("%d tokens", tokensUsed))
+
+	return kept, nil
+}
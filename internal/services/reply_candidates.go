@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+// maxReplyCandidates caps the variants generated per reply
+const maxReplyCandidates = 5
+
+// candidateStyles steer each reply variant in a different direction; the first
+// variant follows the account settings alone
+var candidateStyles = []string{
+	"",
+	"Keep it short and punchy.",
+	"Ask the author a thoughtful follow-up question.",
+	"Add a concrete example, detail or number.",
+	"Offer a respectful contrarian angle.",
+}
+
+// generateCandidates asks the LLM for count reply variants, each with its own
+// style; later variants run at a higher temperature. Failed variants are skipped; it errors only
+// when none succeed.
+func (s *ReplyService) generateCandidates(ctx context.Context, accountID string, llm ports.LLMProvider, req ports.ReplyRequest, baseTemp float64, count int) ([]domain.ReplyCandidate, int, error) {
+	var candidates []domain.ReplyCandidate
+	tokensUsed := 0
+	var lastErr error
+	for i := 0; i < count; i++ {
+		variant := req
+		variant.Style = candidateStyles[i%len(candidateStyles)]
+		temperature := baseTemp
+		if i > 0 {
+			if temperature <= 0 {
+				temperature = 0.7
+			}
+			temperature = math.Min(temperature+0.15*float64(i), 1.3)
+			variant.Temperature = temperature
+		}
+
+		resp, err := llm.GenerateReply(ctx, variant)
+		if err != nil {
+			lastErr = err
+			if count > 1 {
+				s.log(accountID, domain.ActivityLevelWarning, fmt.Sprintf("Reply candidate %d failed", i+1), err.Error())
+			}
+			continue
+		}
+
+		tokensUsed += resp.TokensUsed
+		candidates = append(candidates, domain.ReplyCandidate{
+			Text:        resp.Text,
+			Style:       variant.Style,
+			Temperature: temperature,
+			TokensUsed:  resp.TokensUsed,
+		})
+	}
+
+	if len(candidates) == 0 {
+		return nil, 0, lastErr
+	}
+	return candidates, tokensUsed, nil
+}
+
+// SelectReplyCandidate makes one of a pending reply's candidates its text
+func (s *ReplyService) SelectReplyCandidate(replyID string, index int) error {
+	reply, err := s.findPending(replyID)
+	if err != nil {
+		return err
+	}
+	if index < 0 || index >= len(reply.Candidates) {
+		return fmt.Errorf("reply has no candidate %d", index+1)
+	}
+
+	reply.ChosenCandidate = index
+	reply.Text = reply.Candidates[index].Text
+	return s.replyStore.UpdatePendingReply(*reply)
+}
+
+// recordCandidateChoice stores the reviewer's pick for prompt tuning; chosen is
+// -1 when the reply was rejected
+func (s *ReplyService) recordCandidateChoice(reply domain.Reply, chosen int) {
+	if err := s.replyStore.SaveCandidateChoice(reply, chosen); err != nil {
+		s.log(reply.AccountID, domain.ActivityLevelWarning, "Failed to record reply candidate choice", err.Error())
+	}
+}
@@ -0,0 +1,233 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+// Engagement on a posted reply is recorded at each of these ages
+var replyMetricsCheckpoints = []time.Duration{
+	time.Hour,
+	6 * time.Hour,
+	24 * time.Hour,
+	72 * time.Hour,
+}
+
+const (
+	replyMetricsInterval = 10 * time.Minute
+	replyMetricsDelay    = 2 * time.Minute // Let workers start up first
+	// Checkpoints missed while the app was closed are caught up within this window
+	replyMetricsLookback = 7 * 24 * time.Hour
+)
+
+// ReplyMetricsService polls engagement on posted replies for reply performance reports
+type ReplyMetricsService struct {
+	accountSvc   *AccountService
+	replyStore   ports.ReplyStore
+	metricsStore ports.MetricsStore
+
+	mu     sync.Mutex
+	stopCh chan struct{}
+}
+
+// NewReplyMetricsService creates a new reply metrics service
+func NewReplyMetricsService(
+	accountSvc *AccountService,
+	replyStore ports.ReplyStore,
+	metricsStore ports.MetricsStore,
+) *ReplyMetricsService {
+	return &ReplyMetricsService{
+		accountSvc:   accountSvc,
+		replyStore:   replyStore,
+		metricsStore: metricsStore,
+	}
+}
+
+// Start begins polling reply engagement in the background
+func (s *ReplyMetricsService) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopCh != nil {
+		return // Already running
+	}
+	s.stopCh = make(chan struct{})
+	go s.run(s.stopCh)
+}
+
+// Stop stops the background poller
+func (s *ReplyMetricsService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopCh != nil {
+		close(s.stopCh)
+		s.stopCh = nil
+	}
+}
+
+func (s *ReplyMetricsService) run(stopCh chan struct{}) {
+	select {
+	case <-stopCh:
+		return
+	case <-time.After(replyMetricsDelay):
+	}
+
+	ticker := time.NewTicker(replyMetricsInterval)
+	defer ticker.Stop()
+
+	for {
+		s.pollDue(stopCh)
+
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollDue records metrics for every posted reply that has passed a checkpoint
+// since its last snapshot
+func (s *ReplyMetricsService) pollDue(stopCh chan struct{}) {
+	now := time.Now()
+	replies, err := s.replyStore.GetPostedRepliesSince(now.Add(-replyMetricsLookback))
+	if err != nil {
+		log.Printf("[ReplyMetricsService] Failed to load posted replies: %v", err)
+		return
+	}
+
+	clients := make(map[string]ports.TwitterClient)
+	recorded := 0
+	for _, reply := range replies {
+		select {
+		case <-stopCh:
+			return
+		default:
+		}
+
+		if !s.metricsDue(reply, now) {
+			continue
+		}
+
+		client, ok := clients[reply.AccountID]
+		if !ok {
+			client, err = s.accountSvc.GetClient(reply.AccountID)
+			if err != nil {
+				log.Printf("[ReplyMetricsService] No client for %s: %v", reply.AccountID, err)
+			}
+			clients[reply.AccountID] = client
+		}
+		if client == nil {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		if _, err := s.recordReplyMetrics(ctx, client, reply); err != nil {
+			log.Printf("[ReplyMetricsService] Failed to record metrics for reply %s: %v", reply.ID, err)
+		} else {
+			recorded++
+		}
+		cancel()
+	}
+
+	if recorded > 0 {
+		log.Printf("[ReplyMetricsService] Recorded metrics for %d repl%s", recorded, pluralY(recorded))
+	}
+}
+
+// metricsDue returns true if the reply's latest passed checkpoint has no snapshot yet
+func (s *ReplyMetricsService) metricsDue(reply domain.Reply, now time.Time) bool {
+	if reply.PostedAt == nil {
+		return false
+	}
+	checkpoint := lastCheckpoint(*reply.PostedAt, now)
+	if checkpoint.IsZero() {
+		return false
+	}
+
+	last, err := s.metricsStore.LastReplyMetricsAt(reply.ID)
+	if err != nil {
+		log.Printf("[ReplyMetricsService] Failed to read metrics for reply %s: %v", reply.ID, err)
+		return false
+	}
+	return last.Before(checkpoint)
+}
+
+// lastCheckpoint returns the latest checkpoint time that has passed for a reply
+// posted at the given time; zero if none has
+func lastCheckpoint(postedAt, now time.Time) time.Time {
+	var checkpoint time.Time
+	for _, age := range replyMetricsCheckpoints {
+		t := postedAt.Add(age)
+		if t.After(now) {
+			break
+		}
+		checkpoint = t
+	}
+	return checkpoint
+}
+
+// recordReplyMetrics fetches the posted reply's engagement and stores a snapshot
+func (s *ReplyMetricsService) recordReplyMetrics(ctx context.Context, client ports.TwitterClient, reply domain.Reply) (*domain.ReplyMetrics, error) {
+	tm, err := client.GetTweetMetrics(ctx, reply.PostedReplyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tweet metrics: %w", err)
+	}
+
+	metrics := domain.ReplyMetrics{
+		ReplyID:         reply.ID,
+		AccountID:       reply.AccountID,
+		OriginalTweetID: reply.TweetID,
+		Timestamp:       time.Now(),
+		LikeCount:       tm.LikeCount,
+		RetweetCount:    tm.RetweetCount,
+		Impressions:     tm.Impressions,
+	}
+	if err := s.metricsStore.SaveReplyMetrics(metrics); err != nil {
+		return nil, fmt.Errorf("failed to save reply metrics: %w", err)
+	}
+
+	return &metrics, nil
+}
+
+// GetReplyPerformance returns an account's reply performance report with reply
+// counts and the text of its top replies
+func (s *ReplyMetricsService) GetReplyPerformance(accountID string, days int) (*domain.ReplyPerformanceReport, error) {
+	report, err := s.metricsStore.GetReplyPerformance(accountID, days)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.replyStore.GetReplyStats(time.Now().AddDate(0, 0, -days))
+	if err == nil {
+		for _, st := range stats {
+			if st.AccountID == accountID {
+				report.SuccessfulReplies = st.Posted
+				report.FailedReplies = st.Failed
+				report.PendingReplies = st.Pending
+			}
+		}
+	}
+
+	fillReplyDetails(s.replyStore, report.TopPerformingReplies)
+	return report, nil
+}
+
+// fillReplyDetails adds the reply text and posted tweet ID to metrics rows
+func fillReplyDetails(replyStore ports.ReplyStore, metrics []domain.ReplyMetrics) {
+	for i := range metrics {
+		reply, err := replyStore.GetReplyByID(metrics[i].ReplyID)
+		if err != nil {
+			continue
+		}
+		metrics[i].Text = reply.Text
+		metrics[i].PostedReplyID = reply.PostedReplyID
+	}
+}
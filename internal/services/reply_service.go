@@ -2,11 +2,15 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 
+	"xtools/internal/adapters/twitter"
 	"xtools/internal/domain"
 	"xtools/internal/ports"
 )
@@ -20,6 +24,8 @@ type ReplyService struct {
 	metricsStore   ports.MetricsStore
 	eventBus       ports.EventBus
 	activityLogger ports.ActivityLogger
+
+	scheduleMu sync.Mutex // Serializes posting slot assignment
 }
 
 // NewReplyService creates a new reply service
@@ -45,6 +51,12 @@ func NewReplyService(
 
 // GenerateReply generates a reply for a tweet using LLM
 func (s *ReplyService) GenerateReply(ctx context.Context, accountID string, tweet domain.Tweet) (*domain.Reply, error) {
+	return s.generateReply(ctx, accountID, tweet, nil, 0)
+}
+
+// generateReply generates a reply with the given thread as context, fetching it
+// when nil. followUpDepth > 0 makes it a follow-up to a reply to ours.
+func (s *ReplyService) generateReply(ctx context.Context, accountID string, tweet domain.Tweet, thread []domain.Tweet, followUpDepth int) (*domain.Reply, error) {
 	s.log(accountID, domain.ActivityLevelInfo, "Checking tweet eligibility", fmt.Sprintf("@%s: %s", tweet.AuthorUsername, truncate(tweet.Text, 50)))
 
 	cfg, err := s.accountSvc.GetAccount(accountID)
@@ -68,26 +80,26 @@ func (s *ReplyService) GenerateReply(ctx context.Context, accountID string, twee
 	}
 	defer llm.Close()
 
-	// Skip slow operations for browser auth (thread context and author bio)
-	// These require additional page navigations which are very slow
 	var threadContext []domain.Tweet
 	var authorBio string
 
-	if cfg.AuthType == domain.AuthTypeAPI {
-		// Only fetch thread context for API auth (it's fast)
+	// Thread context is a single lookup in both modes (one page load in browser mode)
+	if thread == nil {
 		s.log(accountID, domain.ActivityLevelInfo, "Fetching thread context", "")
-		_, thread, _ := s.searchSvc.GetTweetDetails(ctx, accountID, tweet.ID)
-		if len(thread) > 0 {
-			threadContext = thread
-		}
+		_, thread, _ = s.searchSvc.GetTweetDetails(ctx, accountID, tweet.ID)
+	}
+	if len(thread) > 1 {
+		threadContext = thread
+		s.log(accountID, domain.ActivityLevelInfo, "Thread context loaded", fmt.Sprintf("%d tweet(s)", len(thread)))
+	}
 
-		// Only fetch author bio for API auth
+	if tweet.AuthorBio != "" {
+		authorBio = tweet.AuthorBio
+	} else if tweet.AuthorUsername != "" {
 		s.log(accountID, domain.ActivityLevelInfo, "Fetching author info", tweet.AuthorUsername)
 		if author, _ := s.searchSvc.GetAuthorInfo(ctx, accountID, tweet.AuthorUsername); author != nil {
 			authorBio = author.Bio
 		}
-	} else {
-		s.log(accountID, domain.ActivityLevelInfo, "Skipping thread/author fetch (browser mode)", "")
 	}
 
 	s.log(accountID, domain.ActivityLevelInfo, "Generating reply with LLM", "")
@@ -104,22 +116,56 @@ func (s *ReplyService) GenerateReply(ctx context.Context, accountID string, twee
 		IncludeHashtags: cfg.ReplyConfig.IncludeHashtags,
 	}
 
-	resp, err := llm.GenerateReply(ctx, req)
+	// Experiment variants override the persona and prompt for a share of replies
+	variant := pickVariant(cfg.Experiment)
+	if variant != nil {
+		applyVariant(&req, variant)
+		s.log(accountID, domain.ActivityLevelInfo, "Using experiment variant", variant.Name)
+	}
+
+	if followUpDepth > 0 {
+		req.Instructions = strings.TrimSpace(req.Instructions + "\n" + followUpInstructions(cfg.Username))
+	}
+
+	// Candidates are only worth generating when a reviewer will pick one
+	count := 1
+	if cfg.DebugMode || cfg.ReplyConfig.ApprovalMode != domain.ApprovalModeAuto {
+		count = max(1, min(cfg.ReplyConfig.Candidates, maxReplyCandidates))
+	}
+
+	candidates, tokensUsed, err := s.generateCandidates(ctx, accountID, llm, req, cfg.LLMConfig.Temperature, count)
 	if err != nil {
 		s.log(accountID, domain.ActivityLevelError, "LLM generation failed", err.Error())
 		return nil, fmt.Errorf("failed to generate reply: %w", err)
 	}
 
-	s.log(accountID, domain.ActivityLevelSuccess, "Reply generated", truncate(resp.Text, 100))
+	s.log(accountID, domain.ActivityLevelSuccess, "Reply generated", truncate(candidates[0].Text, 100))
 
 	reply := &domain.Reply{
 		ID:            uuid.New().String(),
 		TweetID:       tweet.ID,
 		AccountID:     accountID,
-		Text:          resp.Text,
+		Text:          candidates[0].Text,
 		GeneratedAt:   time.Now(),
 		Status:        domain.ReplyStatusPending,
-		LLMTokensUsed: resp.TokensUsed,
+		LLMTokensUsed: tokensUsed,
+		TweetAuthor:   tweet.AuthorUsername,
+	}
+	if len(candidates) > 1 {
+		reply.Candidates = candidates
+	}
+	if variant != nil {
+		reply.Variant = variant.Name
+	}
+	reply.FollowUpDepth = followUpDepth
+
+	// Credit the keywords that found the tweet
+	s.searchSvc.RecordReplyKeywords(accountID, reply.ID, tweet.MatchedKeywords)
+
+	// Keep what was replied to for the reply history
+	tweet.AuthorBio = authorBio
+	if err := s.replyStore.SaveTweets([]domain.Tweet{tweet}); err != nil {
+		s.log(accountID, domain.ActivityLevelWarning, "Failed to save tweet", err.Error())
 	}
 
 	s.eventBus.Emit(ports.EventReplyGenerated, ports.ReplyEvent{
@@ -166,26 +212,36 @@ func (s *ReplyService) QueueReply(reply domain.Reply, tweet domain.Tweet) error
 
 // GetPendingReplies returns pending replies for an account
 func (s *ReplyService) GetPendingReplies(accountID string) ([]domain.ApprovalQueueItem, error) {
-	return s.replyStore.GetPendingReplies(accountID)
+	items, err := s.replyStore.GetPendingReplies(accountID)
+	if err != nil {
+		return nil, err
+	}
+	setQueuePriority(items, time.Now())
+	return items, nil
 }
 
-// ApproveReply approves and posts a pending reply
-func (s *ReplyService) ApproveReply(ctx context.Context, replyID string) error {
-	// Get the reply from pending queue
-	reply, err := s.replyStore.GetReplyByID(replyID)
+// ApproveReply approves a pending reply and schedules it for posting
+func (s *ReplyService) ApproveReply(replyID string) error {
+	// Only replies awaiting approval can be approved; anything else in the
+	// history (posted, rejected, failed, ...) would be posted again
+	reply, err := s.findPending(replyID)
 	if err != nil {
-		// Try pending replies
-		pending, _ := s.replyStore.GetPendingReplies("")
-		for _, p := range pending {
-			if p.Reply.ID == replyID {
-				reply = &p.Reply
-				break
-			}
+		stored, getErr := s.replyStore.GetReplyByID(replyID)
+		if getErr != nil {
+			return fmt.Errorf("reply not found")
+		}
+		switch stored.Status {
+		case domain.ReplyStatusPending:
+			reply = stored
+		case domain.ReplyStatusExpired:
+			return domain.ErrReplyExpired
+		default:
+			return fmt.Errorf("reply is %s, only pending replies can be approved", stored.Status)
 		}
 	}
 
-	if reply == nil {
-		return fmt.Errorf("reply not found")
+	if len(reply.Candidates) > 0 {
+		s.recordCandidateChoice(*reply, reply.ChosenCandidate)
 	}
 
 	reply.Status = domain.ReplyStatusApproved
@@ -197,12 +253,15 @@ func (s *ReplyService) ApproveReply(ctx context.Context, replyID string) error {
 		TweetID:   reply.TweetID,
 	})
 
-	// Post the reply
-	return s.PostReply(ctx, *reply)
+	return s.ScheduleReply(*reply)
 }
 
 // RejectReply rejects a pending reply
 func (s *ReplyService) RejectReply(replyID string) error {
+	if pending, err := s.findPending(replyID); err == nil && len(pending.Candidates) > 0 {
+		s.recordCandidateChoice(*pending, -1)
+	}
+
 	if err := s.replyStore.UpdateReplyStatus(replyID, domain.ReplyStatusRejected); err != nil {
 		return err
 	}
@@ -216,6 +275,11 @@ func (s *ReplyService) RejectReply(replyID string) error {
 
 // EditReply updates a pending reply's text
 func (s *ReplyService) EditReply(replyID, newText string) error {
+	if pending, err := s.findPending(replyID); err == nil {
+		pending.Text = newText
+		return s.replyStore.UpdatePendingReply(*pending)
+	}
+
 	reply, err := s.replyStore.GetReplyByID(replyID)
 	if err != nil {
 		return err
@@ -225,11 +289,46 @@ func (s *ReplyService) EditReply(replyID, newText string) error {
 	return s.replyStore.SaveReply(*reply)
 }
 
+// SetReplyMedia replaces the media attached to a reply in the approval queue
+func (s *ReplyService) SetReplyMedia(replyID string, media []domain.MediaAttachment) error {
+	if err := twitter.ValidateMedia(media); err != nil {
+		return err
+	}
+
+	reply, err := s.findPending(replyID)
+	if err != nil {
+		return err
+	}
+
+	reply.Media = media
+	return s.replyStore.UpdatePendingReply(*reply)
+}
+
+// findPending returns a reply from the approval queue
+func (s *ReplyService) findPending(replyID string) (*domain.Reply, error) {
+	pending, err := s.replyStore.GetPendingReplies("")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range pending {
+		if p.Reply.ID == replyID {
+			return &p.Reply, nil
+		}
+	}
+
+	return nil, fmt.Errorf("pending reply not found")
+}
+
 // PostReply posts a reply to Twitter using the configured reply method (API or browser)
 func (s *ReplyService) PostReply(ctx context.Context, reply domain.Reply) error {
 	// Check if already replied to this tweet (prevent duplicate replies)
 	if replied, _ := s.metricsStore.IsReplied(reply.AccountID, reply.TweetID); replied {
 		s.log(reply.AccountID, domain.ActivityLevelWarning, "Already replied to this tweet", reply.TweetID)
+		// Don't leave a claimed reply in the approved state
+		if reply.Status != domain.ReplyStatusPosted {
+			s.markReplyFailed(reply, domain.ErrAlreadyReplied)
+		}
 		return domain.ErrAlreadyReplied
 	}
 
@@ -237,7 +336,7 @@ func (s *ReplyService) PostReply(ctx context.Context, reply domain.Reply) error
 	cfg, err := s.accountSvc.GetAccount(reply.AccountID)
 	if err != nil {
 		s.log(reply.AccountID, domain.ActivityLevelError, "Failed to get account config", err.Error())
-		s.markReplyFailed(reply.ID, err)
+		s.markReplyFailed(reply, err)
 		return err
 	}
 
@@ -258,7 +357,7 @@ func (s *ReplyService) PostReply(ctx context.Context, reply domain.Reply) error
 
 	if err != nil {
 		s.log(reply.AccountID, domain.ActivityLevelError, "Failed to get client for posting", err.Error())
-		s.markReplyFailed(reply.ID, err)
+		s.markReplyFailed(reply, err)
 		return err
 	}
 	defer client.Close()
@@ -266,7 +365,7 @@ func (s *ReplyService) PostReply(ctx context.Context, reply domain.Reply) error
 	// Retry loop for rate limiting
 	maxRetries := 10
 	for attempt := 0; attempt < maxRetries; attempt++ {
-		postedReply, err := client.PostReply(ctx, reply.TweetID, reply.Text)
+		postedReply, err := s.send(ctx, client, reply)
 		if err == nil {
 			// Success - update reply with posted info
 			reply.Status = domain.ReplyStatusPosted
@@ -276,7 +375,7 @@ func (s *ReplyService) PostReply(ctx context.Context, reply domain.Reply) error
 			s.replyStore.SaveReply(reply)
 			s.metricsStore.MarkReplied(reply.AccountID, reply.TweetID, reply.ID)
 
-			s.log(reply.AccountID, domain.ActivityLevelSuccess, "Reply posted successfully", reply.PostedReplyID)
+			s.logPosted(cfg, reply)
 
 			s.eventBus.Emit(ports.EventReplyPosted, ports.ReplyEvent{
 				AccountID: reply.AccountID,
@@ -288,7 +387,7 @@ func (s *ReplyService) PostReply(ctx context.Context, reply domain.Reply) error
 		}
 
 		// Check if rate limited
-		if err == domain.ErrRateLimited {
+		if errors.Is(err, domain.ErrRateLimited) {
 			// Get rate limit reset time
 			rateLimit := client.GetRateLimitStatus()
 			waitDuration := 60 * time.Second // Default 1 minute wait
@@ -308,7 +407,7 @@ func (s *ReplyService) PostReply(ctx context.Context, reply domain.Reply) error
 			select {
 			case <-ctx.Done():
 				s.log(reply.AccountID, domain.ActivityLevelError, "Context cancelled while waiting for rate limit", "")
-				s.markReplyFailed(reply.ID, ctx.Err())
+				s.markReplyFailed(reply, ctx.Err())
 				return ctx.Err()
 			case <-time.After(waitDuration):
 				// Continue to retry
@@ -318,29 +417,121 @@ func (s *ReplyService) PostReply(ctx context.Context, reply domain.Reply) error
 
 		// Non-rate-limit error
 		s.log(reply.AccountID, domain.ActivityLevelError, "Failed to post reply", err.Error())
-		s.markReplyFailed(reply.ID, err)
+		s.markReplyFailed(reply, err)
+
+		// The post may be live (unconfirmed) or already is (duplicate); mark the
+		// tweet replied so re-approving the reply can't post it twice
+		if errors.Is(err, domain.ErrPostUnconfirmed) || errors.Is(err, domain.ErrDuplicatePost) {
+			s.metricsStore.MarkReplied(reply.AccountID, reply.TweetID, reply.ID)
+			s.log(reply.AccountID, domain.ActivityLevelWarning, "Check the account on X: the post may have gone out", reply.TweetID)
+		}
 		return err
 	}
 
 	// Max retries exceeded
 	err = fmt.Errorf("max retries exceeded for rate limit")
 	s.log(reply.AccountID, domain.ActivityLevelError, "Failed to post reply", err.Error())
-	s.markReplyFailed(reply.ID, err)
+	s.markReplyFailed(reply, err)
 	return err
 }
 
-func (s *ReplyService) markReplyFailed(replyID string, err error) {
-	s.replyStore.UpdateReplyStatus(replyID, domain.ReplyStatusFailed)
+// logPosted logs a posted reply. In auto mode with an undo window the entry
+// lets the reply be undone from the activity feed until the window closes.
+func (s *ReplyService) logPosted(cfg *domain.AccountConfig, reply domain.Reply) {
+	if s.activityLogger == nil {
+		return
+	}
+
+	entry := domain.ActivityLog{
+		AccountID: reply.AccountID,
+		Type:      domain.ActivityTypeReply,
+		Level:     domain.ActivityLevelSuccess,
+		Message:   "Reply posted successfully",
+		Details:   reply.PostedReplyID,
+		ReplyID:   reply.ID,
+	}
+	if cfg.ReplyConfig.ApprovalMode == domain.ApprovalModeAuto && !cfg.DebugMode &&
+		cfg.ReplyConfig.UndoWindowSecs > 0 && reply.PostedReplyID != "" {
+		until := time.Now().Add(time.Duration(cfg.ReplyConfig.UndoWindowSecs) * time.Second)
+		entry.UndoUntil = &until
+	}
+	s.activityLogger.LogEntry(entry)
+}
+
+// DeleteReply deletes a posted reply from X and marks it deleted. The tweet
+// stays marked as replied so it is not answered again.
+func (s *ReplyService) DeleteReply(ctx context.Context, replyID string) error {
+	reply, err := s.replyStore.GetReplyByID(replyID)
+	if err != nil {
+		return err
+	}
+	if reply.Status != domain.ReplyStatusPosted {
+		return fmt.Errorf("reply is not posted")
+	}
+	if reply.PostedReplyID == "" {
+		return fmt.Errorf("posted tweet ID is unknown, delete it on X")
+	}
+
+	client, err := s.accountSvc.GetPostingClient(reply.AccountID)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	// Already gone from X: just record it
+	if err := client.DeleteTweet(ctx, reply.PostedReplyID); err != nil && !errors.Is(err, domain.ErrTweetNotFound) {
+		s.log(reply.AccountID, domain.ActivityLevelError, "Failed to delete reply", err.Error())
+		return err
+	}
+
+	reply.Status = domain.ReplyStatusDeleted
+	if err := s.replyStore.SaveReply(*reply); err != nil {
+		return err
+	}
+
+	s.log(reply.AccountID, domain.ActivityLevelInfo, "Reply deleted", reply.PostedReplyID)
+	s.eventBus.Emit(ports.EventReplyDeleted, ports.ReplyEvent{
+		AccountID: reply.AccountID,
+		Reply:     reply,
+		TweetID:   reply.TweetID,
+	})
+
+	return nil
+}
+
+// send posts a reply, or a standalone tweet for alert drafts
+func (s *ReplyService) send(ctx context.Context, client ports.TwitterClient, reply domain.Reply) (*domain.Reply, error) {
+	if reply.Type != domain.ReplyTypeTweet {
+		return client.PostReply(ctx, reply.TweetID, reply.Text, reply.Media...)
+	}
+
+	tweet, err := client.PostTweet(ctx, reply.Text, reply.Media...)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &domain.Reply{PostedAt: &now, PostedReplyID: tweet.ID}, nil
+}
+
+func (s *ReplyService) markReplyFailed(reply domain.Reply, err error) {
+	s.replyStore.UpdateReplyStatus(reply.ID, domain.ReplyStatusFailed)
+
+	// Keep the reason so the history shows why posting failed
+	reply.Status = domain.ReplyStatusFailed
+	reply.ErrorMessage = err.Error()
+	s.replyStore.SaveReply(reply)
 
 	s.eventBus.Emit(ports.EventReplyFailed, ports.ReplyEvent{
-		Reply: &domain.Reply{ID: replyID, Status: domain.ReplyStatusFailed},
-		Error: err.Error(),
+		AccountID: reply.AccountID,
+		Reply:     &domain.Reply{ID: reply.ID, AccountID: reply.AccountID, TweetID: reply.TweetID, Status: domain.ReplyStatusFailed},
+		TweetID:   reply.TweetID,
+		Error:     err.Error(),
 	})
 }
 
-// GetReplyHistory returns reply history for an account
+// GetReplyHistory returns reply history for an account with the tweets replied to
 func (s *ReplyService) GetReplyHistory(accountID string, limit int) ([]domain.Reply, error) {
-	return s.replyStore.GetReplies(accountID, limit)
+	return s.replyStore.GetReplyHistory(accountID, limit)
 }
 
 // ProcessAutoReply generates and optionally auto-posts a reply
@@ -361,6 +552,9 @@ func (s *ReplyService) ProcessAutoReply(ctx context.Context, accountID string, t
 		return err
 	}
 
+	// Violations are shown to reviewers in every mode
+	reply.Violations = s.CheckGuardrails(ctx, cfg, *reply, tweet)
+
 	// In debug mode, always queue for approval (manual review)
 	if cfg.DebugMode {
 		s.log(accountID, domain.ActivityLevelInfo, "Queuing reply for approval (debug mode)", truncate(reply.Text, 50))
@@ -368,8 +562,12 @@ func (s *ReplyService) ProcessAutoReply(ctx context.Context, accountID string, t
 	}
 
 	if cfg.ReplyConfig.ApprovalMode == domain.ApprovalModeAuto {
-		s.log(accountID, domain.ActivityLevelInfo, "Auto-posting reply (auto mode)", "")
-		return s.PostReply(ctx, *reply)
+		if len(reply.Violations) > 0 {
+			s.log(accountID, domain.ActivityLevelInfo, "Queuing reply for approval (failed guardrails)", truncate(reply.Text, 50))
+			return s.QueueReply(*reply, tweet)
+		}
+		s.log(accountID, domain.ActivityLevelInfo, "Scheduling reply (auto mode)", "")
+		return s.ScheduleReply(*reply)
 	}
 
 	s.log(accountID, domain.ActivityLevelInfo, "Queuing reply for approval", truncate(reply.Text, 50))
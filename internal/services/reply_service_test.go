@@ -0,0 +1,68 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+// historyStore serves replies from the history with an empty approval queue
+type historyStore struct {
+	ports.ReplyStore
+	replies map[string]domain.Reply
+	updated []string
+}
+
+func (s *historyStore) GetPendingReplies(accountID string) ([]domain.ApprovalQueueItem, error) {
+	return nil, nil
+}
+
+func (s *historyStore) GetReplyByID(replyID string) (*domain.Reply, error) {
+	reply, ok := s.replies[replyID]
+	if !ok {
+		return nil, domain.ErrAccountNotFound
+	}
+	return &reply, nil
+}
+
+func (s *historyStore) UpdateReplyStatus(replyID string, status domain.ReplyStatus) error {
+	s.updated = append(s.updated, replyID)
+	return nil
+}
+
+func TestApproveReplyRejectsNonPendingReplies(t *testing.T) {
+	store := &historyStore{replies: make(map[string]domain.Reply)}
+	for _, status := range []domain.ReplyStatus{
+		domain.ReplyStatusPosted,
+		domain.ReplyStatusRejected,
+		domain.ReplyStatusFailed,
+		domain.ReplyStatusDeleted,
+		domain.ReplyStatusScheduled,
+		domain.ReplyStatusExpired,
+	} {
+		store.replies[string(status)] = domain.Reply{ID: string(status), AccountID: "acc1", TweetID: "1846987139428634858", Status: status}
+	}
+	svc := &ReplyService{replyStore: store}
+
+	for id, reply := range store.replies {
+		err := svc.ApproveReply(id)
+		switch {
+		case reply.Status == domain.ReplyStatusExpired:
+			if !errors.Is(err, domain.ErrReplyExpired) {
+				t.Errorf("%s: err = %v, want ErrReplyExpired", id, err)
+			}
+		case err == nil || !strings.Contains(err.Error(), "only pending replies"):
+			t.Errorf("%s: err = %v, want a not pending error", id, err)
+		}
+	}
+
+	if err := svc.ApproveReply("missing"); err == nil {
+		t.Error("missing: want an error")
+	}
+	if len(store.updated) > 0 {
+		t.Errorf("statuses changed for %v", store.updated)
+	}
+}
@@ -0,0 +1,351 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+// How often the queue is checked for due posts
+const scheduledPostInterval = 30 * time.Second
+
+// ScheduledPostService publishes original tweets, quote tweets and threads at scheduled times
+type ScheduledPostService struct {
+	accountSvc     *AccountService
+	store          ports.ScheduledPostStore
+	eventBus       ports.EventBus
+	activityLogger ports.ActivityLogger
+
+	mu     sync.Mutex
+	stopCh chan struct{}
+}
+
+// NewScheduledPostService creates a new scheduled post service
+func NewScheduledPostService(
+	accountSvc *AccountService,
+	store ports.ScheduledPostStore,
+	eventBus ports.EventBus,
+	activityLogger ports.ActivityLogger,
+) *ScheduledPostService {
+	return &ScheduledPostService{
+		accountSvc:     accountSvc,
+		store:          store,
+		eventBus:       eventBus,
+		activityLogger: activityLogger,
+	}
+}
+
+// Start begins publishing due posts in the background
+func (s *ScheduledPostService) Start() {
+	s.mu.Lock()
+	if s.stopCh != nil {
+		s.mu.Unlock()
+		return // Already running
+	}
+	s.stopCh = make(chan struct{})
+	stopCh := s.stopCh
+	s.mu.Unlock()
+
+	// Posts left mid-publish by a previous run may already be live
+	if n, err := s.store.FailInterruptedPosts(); err != nil {
+		log.Printf("[ScheduledPostService] Failed to check interrupted posts: %v", err)
+	} else if n > 0 {
+		log.Printf("[ScheduledPostService] Marked %d interrupted post(s) as failed", n)
+	}
+
+	go s.run(stopCh)
+}
+
+// Stop stops the background publisher
+func (s *ScheduledPostService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopCh != nil {
+		close(s.stopCh)
+		s.stopCh = nil
+	}
+}
+
+func (s *ScheduledPostService) run(stopCh chan struct{}) {
+	ticker := time.NewTicker(scheduledPostInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			s.publishDue()
+		}
+	}
+}
+
+// publishDue publishes every post whose scheduled time has passed
+func (s *ScheduledPostService) publishDue() {
+	posts, err := s.store.GetDuePosts(time.Now())
+	if err != nil {
+		log.Printf("[ScheduledPostService] Failed to get due posts: %v", err)
+		return
+	}
+
+	for _, post := range posts {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		s.publish(ctx, post)
+		cancel()
+	}
+}
+
+// SchedulePost validates and queues a post. A zero ScheduledAt publishes on the next check.
+func (s *ScheduledPostService) SchedulePost(post domain.ScheduledPost) (*domain.ScheduledPost, error) {
+	if _, err := s.accountSvc.GetAccount(post.AccountID); err != nil {
+		return nil, err
+	}
+
+	if err := normalizeScheduledPost(&post); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if post.ScheduledAt.IsZero() {
+		post.ScheduledAt = now
+	}
+	post.ID = uuid.New().String()
+	post.Status = domain.ScheduledPostStatusScheduled
+	post.CreatedAt = now
+	post.PostedAt = nil
+	post.PostedIDs = nil
+	post.ErrorMessage = ""
+
+	if err := s.store.SavePost(post); err != nil {
+		return nil, err
+	}
+
+	s.log(post.AccountID, domain.ActivityLevelInfo, fmt.Sprintf("Scheduled %s", post.Type), post.ScheduledAt.Local().Format(time.RFC1123))
+	s.eventBus.Emit(ports.EventPostScheduled, post)
+
+	return &post, nil
+}
+
+// UpdatePost changes the content or time of a post that has not been published yet
+func (s *ScheduledPostService) UpdatePost(post domain.ScheduledPost) error {
+	existing, err := s.store.GetPost(post.ID)
+	if err != nil {
+		return err
+	}
+	if existing.Status != domain.ScheduledPostStatusScheduled {
+		return fmt.Errorf("only scheduled posts can be edited (status: %s)", existing.Status)
+	}
+
+	if err := normalizeScheduledPost(&post); err != nil {
+		return err
+	}
+
+	existing.Type = post.Type
+	existing.Texts = post.Texts
+	existing.QuoteTweetID = post.QuoteTweetID
+	if !post.ScheduledAt.IsZero() {
+		existing.ScheduledAt = post.ScheduledAt
+	}
+
+	return s.store.SavePost(*existing)
+}
+
+// CancelPost cancels a post that has not been published yet
+func (s *ScheduledPostService) CancelPost(postID string) error {
+	post, err := s.store.GetPost(postID)
+	if err != nil {
+		return err
+	}
+	if post.Status != domain.ScheduledPostStatusScheduled {
+		return fmt.Errorf("only scheduled posts can be cancelled (status: %s)", post.Status)
+	}
+
+	post.Status = domain.ScheduledPostStatusCancelled
+	return s.store.SavePost(*post)
+}
+
+// DeletePost removes a post from the queue
+func (s *ScheduledPostService) DeletePost(postID string) error {
+	post, err := s.store.GetPost(postID)
+	if err != nil {
+		return err
+	}
+	if post.Status == domain.ScheduledPostStatusPosting {
+		return fmt.Errorf("post is being published")
+	}
+	return s.store.DeletePost(postID)
+}
+
+// PublishNow publishes a scheduled post immediately
+func (s *ScheduledPostService) PublishNow(ctx context.Context, postID string) error {
+	post, err := s.store.GetPost(postID)
+	if err != nil {
+		return err
+	}
+	if post.Status != domain.ScheduledPostStatusScheduled {
+		return fmt.Errorf("only scheduled posts can be published (status: %s)", post.Status)
+	}
+
+	return s.publish(ctx, *post)
+}
+
+// GetPosts returns scheduled posts for an account (all accounts if empty)
+func (s *ScheduledPostService) GetPosts(accountID string, limit int) ([]domain.ScheduledPost, error) {
+	return s.store.GetPosts(accountID, limit)
+}
+
+// publish posts a claimed scheduled post and records the outcome
+func (s *ScheduledPostService) publish(ctx context.Context, post domain.ScheduledPost) error {
+	claimed, err := s.store.ClaimPost(post.ID)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return nil // Cancelled or already being published
+	}
+	post.Status = domain.ScheduledPostStatusPosting
+
+	s.log(post.AccountID, domain.ActivityLevelInfo, fmt.Sprintf("Publishing scheduled %s", post.Type), truncate(post.Texts[0], 50))
+
+	client, err := s.accountSvc.GetPostingClient(post.AccountID)
+	if err != nil {
+		s.fail(post, err)
+		return err
+	}
+	defer client.Close()
+
+	var posted []domain.Tweet
+	switch post.Type {
+	case domain.ScheduledPostQuote:
+		var tweet *domain.Tweet
+		if tweet, err = client.PostQuoteTweet(ctx, post.QuoteTweetID, post.Texts[0]); err == nil {
+			posted = []domain.Tweet{*tweet}
+		}
+	case domain.ScheduledPostThread:
+		posted, err = client.PostThread(ctx, post.Texts)
+	default:
+		var tweet *domain.Tweet
+		if tweet, err = client.PostTweet(ctx, post.Texts[0]); err == nil {
+			posted = []domain.Tweet{*tweet}
+		}
+	}
+
+	for _, t := range posted {
+		if t.ID != "" {
+			post.PostedIDs = append(post.PostedIDs, t.ID)
+		}
+	}
+
+	if err != nil {
+		// Retry later when rate limited before anything went out
+		if errors.Is(err, domain.ErrRateLimited) && len(posted) == 0 {
+			retryAt := time.Now().Add(15 * time.Minute)
+			if rl := client.GetRateLimitStatus(); rl != nil && rl.ResetAt.After(time.Now()) {
+				retryAt = rl.ResetAt.Add(time.Second)
+			}
+			post.Status = domain.ScheduledPostStatusScheduled
+			post.ScheduledAt = retryAt
+			s.store.SavePost(post)
+			s.log(post.AccountID, domain.ActivityLevelWarning, "Rate limited, rescheduled post", retryAt.Local().Format(time.RFC1123))
+			return err
+		}
+
+		s.fail(post, err)
+		return err
+	}
+
+	now := time.Now()
+	post.Status = domain.ScheduledPostStatusPosted
+	post.PostedAt = &now
+	post.ErrorMessage = ""
+	if err := s.store.SavePost(post); err != nil {
+		log.Printf("[ScheduledPostService] Failed to save posted post %s: %v", post.ID, err)
+	}
+
+	s.log(post.AccountID, domain.ActivityLevelSuccess, fmt.Sprintf("Published scheduled %s", post.Type), strings.Join(post.PostedIDs, ", "))
+	s.eventBus.Emit(ports.EventPostPublished, post)
+
+	return nil
+}
+
+// fail records a publishing error on a post
+func (s *ScheduledPostService) fail(post domain.ScheduledPost, err error) {
+	post.Status = domain.ScheduledPostStatusFailed
+	post.ErrorMessage = err.Error()
+	if saveErr := s.store.SavePost(post); saveErr != nil {
+		log.Printf("[ScheduledPostService] Failed to save failed post %s: %v", post.ID, saveErr)
+	}
+
+	s.log(post.AccountID, domain.ActivityLevelError, fmt.Sprintf("Failed to publish scheduled %s", post.Type), err.Error())
+	s.eventBus.Emit(ports.EventPostFailed, post)
+}
+
+// log logs an activity if logger is available
+func (s *ScheduledPostService) log(accountID string, level domain.ActivityLevel, message, details string) {
+	if s.activityLogger != nil {
+		s.activityLogger.Log(accountID, domain.ActivityTypePost, level, message, details)
+	}
+}
+
+// normalizeScheduledPost trims texts and checks them against the post type
+func normalizeScheduledPost(post *domain.ScheduledPost) error {
+	var texts []string
+	for _, t := range post.Texts {
+		if t = strings.TrimSpace(t); t != "" {
+			texts = append(texts, t)
+		}
+	}
+	post.Texts = texts
+	post.QuoteTweetID = strings.TrimSpace(post.QuoteTweetID)
+
+	// Accept a tweet URL in place of the ID
+	if i := strings.LastIndex(post.QuoteTweetID, "/status/"); i >= 0 {
+		id := post.QuoteTweetID[i+len("/status/"):]
+		if j := strings.IndexAny(id, "/?#"); j >= 0 {
+			id = id[:j]
+		}
+		post.QuoteTweetID = id
+	}
+
+	if post.Type == "" {
+		post.Type = domain.ScheduledPostTweet
+	}
+
+	switch post.Type {
+	case domain.ScheduledPostTweet:
+		if len(texts) != 1 {
+			return fmt.Errorf("a tweet needs exactly one text")
+		}
+	case domain.ScheduledPostQuote:
+		if len(texts) != 1 {
+			return fmt.Errorf("a quote tweet needs exactly one text")
+		}
+		if post.QuoteTweetID == "" {
+			return fmt.Errorf("quote tweet ID is required")
+		}
+	case domain.ScheduledPostThread:
+		if len(texts) < 2 {
+			return fmt.Errorf("a thread needs at least two texts")
+		}
+	default:
+		return fmt.Errorf("invalid post type: %s", post.Type)
+	}
+
+	for i, t := range texts {
+		if n := len([]rune(t)); n > 280 {
+			return fmt.Errorf("%w: text %d has %d characters", domain.ErrReplyTooLong, i+1, n)
+		}
+	}
+
+	return nil
+}
@@ -2,11 +2,15 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	"xtools/internal/adapters/twitter"
 	"xtools/internal/domain"
 	"xtools/internal/ports"
 )
@@ -15,22 +19,32 @@ import (
 type SearchService struct {
 	accountSvc    *AccountService
 	metricsStore  ports.MetricsStore
+	keywordStats  ports.KeywordStatsStore
+	replyStore    ports.ReplyStore
 	excelExporter ports.ExcelExporter
 	eventBus      ports.EventBus
+
+	groupRunsMu sync.Mutex
+	groupRuns   map[string]time.Time // account + keyword group -> last search
 }
 
 // NewSearchService creates a new search service
 func NewSearchService(
 	accountSvc *AccountService,
 	metricsStore ports.MetricsStore,
+	keywordStats ports.KeywordStatsStore,
+	replyStore ports.ReplyStore,
 	excelExporter ports.ExcelExporter,
 	eventBus ports.EventBus,
 ) *SearchService {
 	return &SearchService{
 		accountSvc:    accountSvc,
 		metricsStore:  metricsStore,
+		keywordStats:  keywordStats,
+		replyStore:    replyStore,
 		excelExporter: excelExporter,
 		eventBus:      eventBus,
+		groupRuns:     make(map[string]time.Time),
 	}
 }
 
@@ -46,20 +60,146 @@ func (s *SearchService) SearchTweets(ctx context.Context, accountID string) ([]d
 		return nil, err
 	}
 
-	if len(cfg.SearchConfig.Keywords) == 0 {
-		return nil, nil
+	var found []domain.Tweet
+	if cfg.SearchConfig.PerKeyword {
+		found, err = s.searchGroups(ctx, client, cfg)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if len(cfg.SearchConfig.Keywords) == 0 && !cfg.SearchConfig.Query.HasTerms() {
+			return nil, nil
+		}
+
+		found, err = s.runQuery(ctx, client, cfg, cfg.SearchConfig.Keywords, cfg.SearchConfig.Filters())
+		if err != nil {
+			return nil, err
+		}
+
+		// Find which keywords matched each tweet
+		for i := range found {
+			for _, kw := range cfg.SearchConfig.Keywords {
+				if keywordMatches(kw, found[i].Text) {
+					found[i].MatchedKeywords = append(found[i].MatchedKeywords, kw)
+				}
+			}
+		}
 	}
 
-	// Build query based on auth type
-	var query string
-	if cfg.AuthType == domain.AuthTypeBrowser {
-		// Browser query format: (keyword1 OR keyword2 OR keyword3) min_faves:10 until:YYYY-MM-DD
-		query = s.buildBrowserSearchQuery(cfg)
-	} else {
-		// API query format: keyword1 OR keyword2 OR keyword3
-		query = strings.Join(cfg.SearchConfig.Keywords, " OR ")
+	// Add metadata to tweets
+	now := time.Now()
+	for i := range found {
+		found[i].AccountID = accountID
+		found[i].DiscoveredAt = now
 	}
 
+	// Filter tweets
+	filtered := s.filterTweets(cfg, found)
+
+	// Allowlisted authors first, then by view count (highest first)
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if filtered[i].Allowlisted != filtered[j].Allowlisted {
+			return filtered[i].Allowlisted
+		}
+		return filtered[i].ViewCount > filtered[j].ViewCount
+	})
+
+	s.recordTweetsFound(accountID, filtered)
+
+	if err := s.replyStore.SaveTweets(filtered); err != nil {
+		fmt.Printf("[SearchService] Failed to save tweets for %s: %v\n", accountID, err)
+	}
+
+	// Save to Excel
+	if len(filtered) > 0 {
+		if err := s.excelExporter.AppendTweets(accountID, filtered); err != nil {
+			// Log but don't fail
+		}
+	}
+
+	// Emit events for found tweets
+	for _, tweet := range filtered {
+		s.eventBus.Emit(ports.EventTweetFound, ports.TweetFoundEvent{
+			AccountID: accountID,
+			Tweet:     tweet,
+			Keyword:   strings.Join(tweet.MatchedKeywords, ", "),
+		})
+	}
+
+	return filtered, nil
+}
+
+// searchGroups runs each keyword group that is due as its own query, attributing
+// its tweets to the group
+func (s *SearchService) searchGroups(ctx context.Context, client ports.TwitterClient, cfg *domain.AccountConfig) ([]domain.Tweet, error) {
+	var found []domain.Tweet
+	var firstErr error
+	ran := 0
+
+	for _, group := range cfg.SearchConfig.Groups() {
+		if !s.groupDue(cfg.ID, group) {
+			continue
+		}
+
+		filters := cfg.SearchConfig.Filters()
+		if group.Filters != nil {
+			filters = *group.Filters
+		}
+
+		tweets, err := s.runQuery(ctx, client, cfg, group.Keywords, filters)
+		if err != nil {
+			fmt.Printf("[SearchService] Search for %q failed: %v\n", group.Name, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			if errors.Is(err, domain.ErrRateLimited) {
+				break // Remaining groups run on the next tick
+			}
+			continue
+		}
+		s.markGroupRun(cfg.ID, group.Name)
+		ran++
+
+		for i := range tweets {
+			tweets[i].MatchedKeywords = []string{group.Name}
+		}
+		found = append(found, tweets...)
+	}
+
+	if ran == 0 && firstErr != nil {
+		return nil, firstErr
+	}
+	return found, nil
+}
+
+// groupDue returns true if the group's own interval has passed since its last run
+func (s *SearchService) groupDue(accountID string, group domain.KeywordGroup) bool {
+	if group.IntervalSecs <= 0 {
+		return true
+	}
+
+	s.groupRunsMu.Lock()
+	last, ok := s.groupRuns[accountID+"\x00"+group.Name]
+	s.groupRunsMu.Unlock()
+
+	// Allow for ticker drift
+	interval := time.Duration(group.IntervalSecs)*time.Second - 5*time.Second
+	return !ok || time.Since(last) >= interval
+}
+
+func (s *SearchService) markGroupRun(accountID, groupName string) {
+	s.groupRunsMu.Lock()
+	s.groupRuns[accountID+"\x00"+groupName] = time.Now()
+	s.groupRunsMu.Unlock()
+}
+
+// runQuery searches the keywords as one query, resuming from its since_id cursor
+// and following page tokens up to the page budget. Queries with engagement
+// minimums search the whole window each time, since a tweet below them now may
+// reach them later.
+func (s *SearchService) runQuery(ctx context.Context, client ports.TwitterClient, cfg *domain.AccountConfig, keywords []string, filters domain.SearchFilters) ([]domain.Tweet, error) {
+	q := cfg.SearchConfig.QueryFor(keywords)
+
 	opts := ports.SearchOptions{
 		MaxResults:      100,
 		Lang:            "en",
@@ -70,70 +210,217 @@ func (s *SearchService) SearchTweets(ctx context.Context, accountID string) ([]d
 	if cfg.SearchConfig.EnglishOnly {
 		opts.Lang = "en"
 	}
+	if q.Lang != "" {
+		opts.Lang = q.Lang
+	}
 
-	result, err := client.SearchTweets(ctx, query, opts)
-	if err != nil {
-		return nil, err
+	query := s.buildQuery(cfg, q, filters)
+	if cfg.AuthType != domain.AuthTypeBrowser {
+		// The API has no engagement or date operators
+		opts.MinLikes = filters.MinFaves
+		opts.MinRetweets = filters.MinRetweets
+		opts.StartTime, opts.EndTime, _ = q.TimeRange()
 	}
 
-	var allTweets []domain.Tweet
+	// Only fetch tweets newer than the last run
+	useCursor := filters.MinFaves <= 0 && filters.MinRetweets <= 0 && filters.MinReplies <= 0 && q.MinViews <= 0
+	cursorKey := searchCursorKey(q, opts.Lang)
+	var sinceID string
+	if useCursor {
+		var err error
+		sinceID, err = s.metricsStore.GetSearchCursor(cfg.ID, cursorKey)
+		if err != nil {
+			fmt.Printf("[SearchService] Failed to load search cursor for %s: %v\n", cfg.ID, err)
+		}
+		opts.SinceID = sinceID
+	}
 
-	// Add metadata to tweets and match keywords
-	for i := range result.Tweets {
-		tweet := &result.Tweets[i]
-		tweet.AccountID = accountID
-		tweet.DiscoveredAt = time.Now()
+	// Follow page tokens up to the page budget
+	maxPages := cfg.SearchConfig.MaxPages
+	if maxPages <= 0 {
+		maxPages = 1
+	}
 
-		// Find which keywords matched this tweet
-		textLower := strings.ToLower(tweet.Text)
-		for _, kw := range cfg.SearchConfig.Keywords {
-			// Handle keywords with search operators (e.g., "insider -filter:replies")
-			kwBase := strings.Split(kw, " ")[0] // Get first word before operators
-			if strings.Contains(textLower, strings.ToLower(kwBase)) {
-				tweet.MatchedKeywords = append(tweet.MatchedKeywords, kw)
+	var found []domain.Tweet
+	for page := 0; page < maxPages; page++ {
+		result, err := client.SearchTweets(ctx, query, opts)
+		if err != nil {
+			if page == 0 {
+				return nil, err
 			}
+			fmt.Printf("[SearchService] Stopped paging after %d page(s): %v\n", page, err)
+			break
 		}
 
-		allTweets = append(allTweets, *tweet)
+		found = append(found, result.Tweets...)
+		if result.NextToken == "" {
+			break
+		}
+		opts.NextToken = result.NextToken
 	}
 
-	// Filter tweets
-	filtered := s.filterTweets(cfg, allTweets)
+	// Advance the cursor to the newest tweet seen. Older tweets left beyond the
+	// page budget are skipped on the next run.
+	if newest := newestTweetID(found); useCursor && isNewerID(newest, sinceID) {
+		if err := s.metricsStore.SaveSearchCursor(cfg.ID, cursorKey, newest); err != nil {
+			fmt.Printf("[SearchService] Failed to save search cursor for %s: %v\n", cfg.ID, err)
+		}
+	}
 
-	// Sort by view count (highest first)
-	sort.Slice(filtered, func(i, j int) bool {
-		return filtered[i].ViewCount > filtered[j].ViewCount
-	})
+	// Apply the thresholds neither search syntax supports
+	maxAge := time.Duration(filters.MaxAgeMins) * time.Minute
+	kept := found[:0]
+	for _, tweet := range found {
+		if maxAge > 0 && time.Since(tweet.CreatedAt) > maxAge {
+			continue
+		}
+		if tweet.ViewCount < q.MinViews {
+			continue
+		}
+		if cfg.AuthType != domain.AuthTypeBrowser && tweet.ReplyCount < filters.MinReplies {
+			continue
+		}
+		kept = append(kept, tweet)
+	}
+	return kept, nil
+}
 
-	// Save to Excel
-	if len(filtered) > 0 {
-		if err := s.excelExporter.AppendTweets(accountID, filtered); err != nil {
-			// Log but don't fail
+// buildQuery renders a query in the syntax of the account's search client
+func (s *SearchService) buildQuery(cfg *domain.AccountConfig, q domain.SearchQuery, filters domain.SearchFilters) string {
+	if cfg.AuthType == domain.AuthTypeBrowser {
+		return s.buildBrowserSearchQuery(q, filters)
+	}
+
+	// The API client adds the language from the search options
+	q.Lang = ""
+	return twitter.BuildAPIQuery(q)
+}
+
+// PreviewQuery validates an account's search settings and returns the query its
+// next combined search would send
+func (s *SearchService) PreviewQuery(cfg *domain.AccountConfig) (string, error) {
+	if err := validateSearchQuery(cfg); err != nil {
+		return "", err
+	}
+	return s.buildQuery(cfg, cfg.SearchConfig.QueryFor(cfg.SearchConfig.Keywords), cfg.SearchConfig.Filters()), nil
+}
+
+// keywordMatches reports whether text contains all search terms of a keyword.
+// Operators such as -filter:replies or lang:en are ignored, and OR-separated
+// alternatives match if any of them does.
+func keywordMatches(keyword, text string) bool {
+	text = strings.ToLower(text)
+	for _, alt := range strings.Split(keyword, " OR ") {
+		terms := keywordTermRe.FindAllString(alt, -1)
+		matched := 0
+		for _, term := range terms {
+			if strings.HasPrefix(term, "-") || (strings.Contains(term, ":") && !strings.HasPrefix(term, `"`)) || term == "AND" {
+				continue
+			}
+			term = strings.ToLower(strings.Trim(term, `"()`))
+			if term == "" {
+				continue
+			}
+			if !strings.Contains(text, term) {
+				matched = -1
+				break
+			}
+			matched++
+		}
+		if matched > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+var keywordTermRe = regexp.MustCompile(`"[^"]*"|\S+`)
+
+// recordTweetsFound counts found tweets per matched keyword for keyword stats
+func (s *SearchService) recordTweetsFound(accountID string, tweets []domain.Tweet) {
+	if s.keywordStats == nil || len(tweets) == 0 {
+		return
+	}
+
+	counts := make(map[string]int)
+	for _, tweet := range tweets {
+		for _, kw := range tweet.MatchedKeywords {
+			counts[kw]++
 		}
 	}
+	if err := s.keywordStats.RecordTweetsFound(accountID, counts); err != nil {
+		fmt.Printf("[SearchService] Failed to record keyword stats for %s: %v\n", accountID, err)
+	}
+}
 
-	// Emit events for found tweets
-	for _, tweet := range filtered {
-		s.eventBus.Emit(ports.EventTweetFound, ports.TweetFoundEvent{
-			AccountID: accountID,
-			Tweet:     tweet,
-			Keyword:   strings.Join(tweet.MatchedKeywords, ", "),
-		})
+// RecordReplyKeywords attributes a generated reply to the keywords that found its tweet
+func (s *SearchService) RecordReplyKeywords(accountID, replyID string, keywords []string) {
+	if s.keywordStats == nil || len(keywords) == 0 {
+		return
 	}
+	if err := s.keywordStats.RecordReplyKeywords(accountID, replyID, keywords); err != nil {
+		fmt.Printf("[SearchService] Failed to record reply keywords for %s: %v\n", accountID, err)
+	}
+}
 
-	return filtered, nil
+// GetKeywordStats returns per-keyword conversion stats for the last days
+func (s *SearchService) GetKeywordStats(accountID string, days int) ([]domain.KeywordStats, error) {
+	if s.keywordStats == nil {
+		return nil, fmt.Errorf("keyword stats are not available")
+	}
+	if days <= 0 {
+		days = 30
+	}
+	return s.keywordStats.GetKeywordStats(accountID, days)
+}
+
+// searchCursorKey identifies a query; changing any part of it (keywords,
+// authors, language, ...) starts a new cursor. It is built before the browser
+// query's until: timestamp and engagement operators are added, so consecutive
+// runs share a key.
+func searchCursorKey(q domain.SearchQuery, lang string) string {
+	q.Lang = ""
+	key := "search:" + strings.ToLower(strings.TrimSpace(twitter.BuildWebQuery(q)))
+	if lang != "" {
+		key += " lang:" + lang
+	}
+	return key
+}
+
+// newestTweetID returns the highest tweet ID in the list
+func newestTweetID(tweets []domain.Tweet) string {
+	var newest string
+	for _, t := range tweets {
+		if isNewerID(t.ID, newest) {
+			newest = t.ID
+		}
+	}
+	return newest
+}
+
+// isNewerID compares snowflake tweet IDs, which grow over time
+func isNewerID(id, than string) bool {
+	if len(id) != len(than) {
+		return len(id) > len(than)
+	}
+	return id > than
 }
 
 func (s *SearchService) filterTweets(cfg *domain.AccountConfig, tweets []domain.Tweet) []domain.Tweet {
 	var filtered []domain.Tweet
-	seen := make(map[string]bool)
+	seen := make(map[string]int) // tweet ID -> index in filtered, or -1 if dropped
+	interactions := s.authorInteractions(cfg.ID, tweets)
+	now := time.Now()
 
 	for _, tweet := range tweets {
-		// Skip duplicates
-		if seen[tweet.ID] {
+		// Merge duplicates found by several queries
+		if i, ok := seen[tweet.ID]; ok {
+			if i >= 0 {
+				filtered[i].MatchedKeywords = mergeKeywords(filtered[i].MatchedKeywords, tweet.MatchedKeywords)
+			}
 			continue
 		}
-		seen[tweet.ID] = true
+		seen[tweet.ID] = -1
 
 		// Skip already replied tweets
 		if replied, _ := s.metricsStore.IsReplied(cfg.ID, tweet.ID); replied {
@@ -155,30 +442,70 @@ func (s *SearchService) filterTweets(cfg *domain.AccountConfig, tweets []domain.
 			continue
 		}
 
-		// Check minimum age
-		if cfg.SearchConfig.MaxAgeMins > 0 {
-			age := time.Since(tweet.CreatedAt)
-			if age.Minutes() > float64(cfg.SearchConfig.MaxAgeMins) {
-				continue
-			}
+		// Skip low quality authors unless allowlisted
+		tweet.Allowlisted = containsUser(cfg.SearchConfig.Allowlist, tweet.AuthorUsername)
+		tweet.AuthorScore = scoreAuthor(tweet, interactions[strings.ToLower(tweet.AuthorUsername)], now)
+		if !tweet.Allowlisted && tweet.AuthorScore < cfg.SearchConfig.MinAuthorScore {
+			continue
 		}
 
+		seen[tweet.ID] = len(filtered)
 		filtered = append(filtered, tweet)
 	}
 
 	return filtered
 }
 
+// mergeKeywords appends keywords not already in the list
+func mergeKeywords(list, more []string) []string {
+	for _, kw := range more {
+		found := false
+		for _, existing := range list {
+			if existing == kw {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, kw)
+		}
+	}
+	return list
+}
+
 func (s *SearchService) isBlocklisted(blocklist []string, username string) bool {
-	username = strings.ToLower(username)
-	for _, blocked := range blocklist {
-		if strings.ToLower(blocked) == username {
+	return containsUser(blocklist, username)
+}
+
+// containsUser reports whether a username list (with or without @) has the user
+func containsUser(list []string, username string) bool {
+	for _, u := range list {
+		if strings.EqualFold(strings.TrimPrefix(strings.TrimSpace(u), "@"), username) {
 			return true
 		}
 	}
 	return false
 }
 
+// authorInteractions returns our posted replies per author (lowercase username)
+func (s *SearchService) authorInteractions(accountID string, tweets []domain.Tweet) map[string]int {
+	seen := make(map[string]bool)
+	var authors []string
+	for _, tweet := range tweets {
+		if name := strings.ToLower(tweet.AuthorUsername); name != "" && !seen[name] {
+			seen[name] = true
+			authors = append(authors, name)
+		}
+	}
+
+	counts, err := s.replyStore.CountPostedRepliesByAuthor(accountID, authors)
+	if err != nil {
+		fmt.Printf("[SearchService] Failed to load author interactions for %s: %v\n", accountID, err)
+		return nil
+	}
+	return counts
+}
+
 func (s *SearchService) containsExcludedKeywords(excluded []string, text string) bool {
 	textLower := strings.ToLower(text)
 	for _, kw := range excluded {
@@ -191,28 +518,31 @@ func (s *SearchService) containsExcludedKeywords(excluded []string, text string)
 
 // buildBrowserSearchQuery builds a search query optimized for browser-based search
 // Format: (keyword1 OR keyword2 OR keyword3) min_faves:N min_replies:N min_retweets:N until:UNIX_TIMESTAMP -filter:replies
-func (s *SearchService) buildBrowserSearchQuery(cfg *domain.AccountConfig) string {
-	// Build keywords with OR inside parentheses
-	keywords := "(" + strings.Join(cfg.SearchConfig.Keywords, " OR ") + ")"
+func (s *SearchService) buildBrowserSearchQuery(q domain.SearchQuery, f domain.SearchFilters) string {
+	// Build keywords with OR inside parentheses, followed by the structured operators
+	keywords := twitter.BuildWebQuery(q)
 
 	// Build filters
 	var filters []string
 
 	// min_faves filter 
-	minFaves := cfg.SearchConfig.MinFaves
+	minFaves := f.MinFaves
 	filters = append(filters, fmt.Sprintf("min_faves:%d", minFaves))
 
 	// min_replies filter
-	minReplies := cfg.SearchConfig.MinReplies
+	minReplies := f.MinReplies
 	filters = append(filters, fmt.Sprintf("min_replies:%d", minReplies))
 
 	// min_retweets filter
-	minRetweets := cfg.SearchConfig.MinRetweets
+	minRetweets := f.MinRetweets
 	filters = append(filters, fmt.Sprintf("min_retweets:%d", minRetweets))
 
-	// until: current time minus 10 minutes (to avoid very recent tweets that may be spam)
-	untilTime := time.Now().Add(-10 * time.Minute)
-	filters = append(filters, fmt.Sprintf("until:%d", untilTime.Unix()))
+	// until: current time minus 10 minutes (to avoid very recent tweets that may be spam),
+	// unless the query has its own end date
+	if q.Until == "" {
+		untilTime := time.Now().Add(-10 * time.Minute)
+		filters = append(filters, fmt.Sprintf("until:%d", untilTime.Unix()))
+	}
 
 	// Exclude replies
 	filters = append(filters, "-filter:replies")
@@ -228,14 +558,19 @@ func (s *SearchService) GetTweetDetails(ctx context.Context, accountID, tweetID
 		return nil, nil, err
 	}
 
-	tweet, err := client.GetTweet(ctx, tweetID)
-	if err != nil {
-		return nil, nil, err
+	// The thread includes the tweet itself, saving a lookup (a page load in browser mode)
+	thread, err := client.GetTweetThread(ctx, tweetID)
+	if err == nil {
+		for i := range thread {
+			if thread[i].ID == tweetID {
+				return &thread[i], thread, nil
+			}
+		}
 	}
 
-	thread, err := client.GetTweetThread(ctx, tweetID)
+	tweet, err := client.GetTweet(ctx, tweetID)
 	if err != nil {
-		return tweet, nil, nil
+		return nil, nil, err
 	}
 
 	return tweet, thread, nil
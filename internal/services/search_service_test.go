@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"xtools/internal/domain"
+	"xtools/internal/ports"
+)
+
+// cursorStore keeps search cursors in memory
+type cursorStore struct {
+	ports.MetricsStore
+	cursors map[string]string
+}
+
+func (s *cursorStore) GetSearchCursor(accountID, queryKey string) (string, error) {
+	return s.cursors[accountID+"|"+queryKey], nil
+}
+
+func (s *cursorStore) SaveSearchCursor(accountID, queryKey, sinceID string) error {
+	s.cursors[accountID+"|"+queryKey] = sinceID
+	return nil
+}
+
+// searchClient returns the next batch of tweets and records the options it got
+type searchClient struct {
+	ports.TwitterClient
+	batches [][]domain.Tweet
+	queries []string
+	opts    []ports.SearchOptions
+}
+
+func (c *searchClient) SearchTweets(ctx context.Context, query string, opts ports.SearchOptions) (*domain.SearchResult, error) {
+	c.queries = append(c.queries, query)
+	c.opts = append(c.opts, opts)
+	var tweets []domain.Tweet
+	if len(c.batches) > 0 {
+		tweets, c.batches = c.batches[0], c.batches[1:]
+	}
+	return &domain.SearchResult{Tweets: tweets}, nil
+}
+
+func TestRunQueryResumesBrowserSearchCursor(t *testing.T) {
+	store := &cursorStore{cursors: make(map[string]string)}
+	svc := &SearchService{metricsStore: store}
+	client := &searchClient{batches: [][]domain.Tweet{
+		{{ID: "1846900000000000000"}, {ID: "1846987139428634858"}},
+		{{ID: "1847001234567890123"}},
+	}}
+	cfg := &domain.AccountConfig{ID: "acc1", AuthType: domain.AuthTypeBrowser}
+
+	for run := 0; run < 2; run++ {
+		if _, err := svc.runQuery(context.Background(), client, cfg, []string{"golang", "rust"}, domain.SearchFilters{}); err != nil {
+			t.Fatalf("run %d: %v", run, err)
+		}
+	}
+
+	// The browser query carries a moving until: timestamp; the cursor key must not
+	if !strings.Contains(client.queries[0], "until:") {
+		t.Fatalf("query = %q, want an until: operator", client.queries[0])
+	}
+	if len(store.cursors) != 1 {
+		t.Errorf("saved %d cursors, want 1 shared by both runs: %v", len(store.cursors), store.cursors)
+	}
+	for key := range store.cursors {
+		if strings.Contains(key, "until:") || strings.Contains(key, "min_faves:") {
+			t.Errorf("cursor key %q contains per-run operators", key)
+		}
+	}
+	if got := client.opts[1].SinceID; got != "1846987139428634858" {
+		t.Errorf("second run SinceID = %q, want the newest tweet of the first run", got)
+	}
+	if got := store.cursors["acc1|"+searchCursorKey(domain.SearchQuery{AnyWords: []string{"golang", "rust"}}, "en")]; got != "1847001234567890123" {
+		t.Errorf("cursor after the second run = %q, want 1847001234567890123", got)
+	}
+}
+
+func TestSearchCursorKey(t *testing.T) {
+	q := domain.SearchQuery{AnyWords: []string{"Golang"}, FromUsers: []string{"@golang"}}
+	if a, b := searchCursorKey(q, "en"), searchCursorKey(q, "en"); a != b {
+		t.Errorf("keys differ between runs: %q, %q", a, b)
+	}
+	if searchCursorKey(q, "en") == searchCursorKey(q, "es") {
+		t.Error("language does not change the key")
+	}
+	other := q
+	other.AnyWords = []string{"rust"}
+	if searchCursorKey(q, "en") == searchCursorKey(other, "en") {
+		t.Error("keywords do not change the key")
+	}
+}
@@ -101,6 +101,10 @@ func (w *SearchWorker) IsRunning() bool {
 	return w.running
 }
 
+// defaultMentionsInterval is how often mentions are checked for follow-ups
+// when the account does not set an interval
+const defaultMentionsInterval = 5 * time.Minute
+
 func (w *SearchWorker) run() {
 	cfg, err := w.configStore.LoadAccount(w.accountID)
 	if err != nil {
@@ -110,6 +114,15 @@ func (w *SearchWorker) run() {
 
 	interval := time.Duration(cfg.SearchConfig.IntervalSecs) * time.Second
 
+	// Keyword groups with a shorter schedule need a faster tick
+	if cfg.SearchConfig.PerKeyword {
+		for _, group := range cfg.SearchConfig.Groups() {
+			if groupInterval := time.Duration(group.IntervalSecs) * time.Second; groupInterval > 0 && groupInterval < interval {
+				interval = groupInterval
+			}
+		}
+	}
+
 	// Store debug mode for rate limit bypass
 	w.debugMode = cfg.DebugMode
 
@@ -131,6 +144,20 @@ func (w *SearchWorker) run() {
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
+	// Mentions are checked on their own schedule; a nil channel never fires
+	var mentionsC <-chan time.Time
+	if cfg.FollowUps.Enabled {
+		mentionsInterval := time.Duration(cfg.FollowUps.IntervalSecs) * time.Second
+		if mentionsInterval == 0 {
+			mentionsInterval = defaultMentionsInterval
+		} else if mentionsInterval < time.Minute {
+			mentionsInterval = time.Minute
+		}
+		mentionsTicker := time.NewTicker(mentionsInterval)
+		defer mentionsTicker.Stop()
+		mentionsC = mentionsTicker.C
+	}
+
 	// Run immediately on start
 	w.doSearch()
 
@@ -140,10 +167,21 @@ func (w *SearchWorker) run() {
 			return
 		case <-ticker.C:
 			w.doSearch()
+		case <-mentionsC:
+			w.doMentions()
 		}
 	}
 }
 
+// doMentions queues follow-ups to replies to the account's replies
+func (w *SearchWorker) doMentions() {
+	ctx, cancel := context.WithTimeout(w.ctx, 5*time.Minute)
+	defer cancel()
+	if err := w.replySvc.ProcessMentions(ctx, w.accountID); err != nil && ctx.Err() == nil {
+		w.activityLogger.Log(w.accountID, domain.ActivityTypeReply, domain.ActivityLevelError, "Mentions check failed", err.Error())
+	}
+}
+
 func (w *SearchWorker) doSearch() {
 	// Skip rate limit check in debug mode
 	if !w.debugMode {
@@ -176,26 +214,21 @@ func (w *SearchWorker) doSearch() {
 	w.activityLogger.Log(w.accountID, domain.ActivityTypeSearch, domain.ActivityLevelSuccess, fmt.Sprintf("Found %d tweets", len(tweets)), "")
 	w.emitStatus("idle")
 
-	// Get delay setting from config
-	delayBetweenReplies := 60 * time.Second // Default 60 seconds
-	if cfg, err := w.configStore.LoadAccount(w.accountID); err == nil && cfg.RateLimits.MinDelayBetween > 0 {
-		delayBetweenReplies = time.Duration(cfg.RateLimits.MinDelayBetween) * time.Second
+	// Drop off-topic tweets before replying
+	if len(tweets) > 0 {
+		relevanceCtx, relevanceCancel := context.WithTimeout(w.ctx, 2*time.Minute)
+		tweets, err = w.replySvc.FilterRelevant(relevanceCtx, w.accountID, tweets)
+		relevanceCancel()
+
+		if err != nil {
+			w.activityLogger.Log(w.accountID, domain.ActivityTypeSearch, domain.ActivityLevelWarning, "Relevance filter unavailable", err.Error())
+		}
 	}
 
-	// Process tweets for auto-reply with longer timeout per tweet (2 minutes each for LLM + posting)
+	// Replies are generated here; the posting queue spaces out the posting
 	for i, tweet := range tweets {
-		// Add delay between replies (skip for first tweet)
-		if i > 0 {
-			w.activityLogger.Log(w.accountID, domain.ActivityTypeWorker, domain.ActivityLevelInfo,
-				fmt.Sprintf("Waiting %v before next reply", delayBetweenReplies), "")
-
-			select {
-			case <-w.ctx.Done():
-				w.activityLogger.Log(w.accountID, domain.ActivityTypeWorker, domain.ActivityLevelWarning, "Worker stopped during delay", "")
-				return
-			case <-time.After(delayBetweenReplies):
-				// Continue after delay
-			}
+		if w.ctx.Err() != nil {
+			return
 		}
 
 		w.activityLogger.Log(w.accountID, domain.ActivityTypeWorker, domain.ActivityLevelInfo, fmt.Sprintf("Processing tweet %d/%d from @%s", i+1, len(tweets), tweet.AuthorUsername), "")